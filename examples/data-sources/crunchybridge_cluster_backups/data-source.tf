@@ -0,0 +1,15 @@
+data "crunchybridge_cluster_backups" "demo" {
+  cluster_id = var.example_id
+}
+
+locals {
+  backups       = data.crunchybridge_cluster_backups.demo.backups
+  latest_backup = local.backups[length(local.backups) - 1]
+}
+
+check "backup_freshness" {
+  assert {
+    condition     = timecmp(local.latest_backup.started_at, timeadd(plantimestamp(), "-26h")) > 0
+    error_message = "No backup has started in the last 26 hours."
+  }
+}
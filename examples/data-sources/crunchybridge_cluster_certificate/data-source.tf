@@ -0,0 +1,14 @@
+data "crunchybridge_cluster_certificate" "ca" {
+  cluster_id = var.example_id
+}
+
+# Make the CA available to applications connecting with sslmode=verify-full
+resource "kubernetes_secret" "postgres_ca" {
+  metadata {
+    name = "postgres-ca"
+  }
+
+  data = {
+    "ca.crt" = data.crunchybridge_cluster_certificate.ca.certificate
+  }
+}
@@ -11,3 +11,7 @@ output "application_uri" {
   value     = data.crunchybridge_clusterroles.default.application.uri
   sensitive = true
 }
+
+output "user_role_names" {
+  value = [for role in data.crunchybridge_clusterroles.default.user_roles : role.name]
+}
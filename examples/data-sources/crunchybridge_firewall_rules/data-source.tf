@@ -0,0 +1,7 @@
+data "crunchybridge_firewall_rules" "allowed" {
+  cluster_id = var.example_id
+}
+
+output "allowed_cidrs" {
+  value = [for rule in data.crunchybridge_firewall_rules.allowed.rules : rule.cidr]
+}
@@ -0,0 +1,8 @@
+data "crunchybridge_network" "by_id" {
+  id = var.example_id
+}
+
+data "crunchybridge_network" "by_name" {
+  team_id = var.example_id
+  name    = "shared-network"
+}
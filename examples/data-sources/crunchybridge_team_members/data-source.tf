@@ -0,0 +1,7 @@
+data "crunchybridge_team_members" "team" {
+  team_id = var.example_id
+}
+
+output "admins" {
+  value = [for m in data.crunchybridge_team_members.team.members : m.email if m.role == "admin"]
+}
@@ -10,5 +10,11 @@ terraform {
 provider "crunchybridge" {
   application_id     = var.api_key
   application_secret = var.api_secret
+
+  default_tags {
+    tags = {
+      cost_center = "analytics"
+    }
+  }
 }
 
@@ -0,0 +1,9 @@
+resource "crunchybridge_api_key" "ci" {
+  name       = "ci-deployments"
+  expires_at = "2025-01-01T00:00:00Z"
+}
+
+output "ci_api_key" {
+  value     = crunchybridge_api_key.ci.secret
+  sensitive = true
+}
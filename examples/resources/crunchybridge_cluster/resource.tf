@@ -3,6 +3,13 @@ data "crunchybridge_account" "user" {}
 resource "crunchybridge_cluster" "demo" {
   team_id = data.crunchybridge_account.user.default_team
   name    = "famously-fragile-impala-47"
+
+  # Allow maintenance to start between 03:00 and 04:00 UTC
+  maintenance_window_start = 3
+
+  tags = {
+    owner = "data-team"
+  }
 }
 
 data "crunchybridge_clusterstatus" "status" {
@@ -12,3 +19,19 @@ data "crunchybridge_clusterstatus" "status" {
 output "demo_status" {
   value = data.crunchybridge_clusterstatus.status
 }
+
+# Restore the demo cluster as it was at a point in time into a staging cluster
+resource "crunchybridge_cluster" "staging" {
+  team_id              = data.crunchybridge_account.user.default_team
+  name                 = "famously-fragile-impala-restore"
+  plan_id              = "standard-4"
+  source_cluster_id    = crunchybridge_cluster.demo.id
+  recovery_target_time = "2024-05-01T12:00:00Z"
+  suspended            = var.staging_suspended
+}
+
+variable "staging_suspended" {
+  description = "Suspend the staging cluster outside of working hours"
+  type        = bool
+  default     = false
+}
@@ -0,0 +1,12 @@
+resource "crunchybridge_cluster_backup" "pre_migration" {
+  cluster_id = var.example_id
+
+  # Take a new backup whenever the migration version changes
+  triggers = {
+    migration = "2024_05_01_add_orders_index"
+  }
+
+  timeouts {
+    create = "2h"
+  }
+}
@@ -0,0 +1,23 @@
+# Restart whenever a parameter that needs a restart is changed
+resource "crunchybridge_cluster_operation" "restart" {
+  cluster_id = crunchybridge_cluster_parameters.tuning.cluster_id
+  kind       = "restart"
+
+  triggers = {
+    max_connections = crunchybridge_cluster_parameters.tuning.parameters["max_connections"]
+  }
+}
+
+# Exercise high availability failover during game days
+resource "crunchybridge_cluster_operation" "failover" {
+  cluster_id = var.example_id
+  kind       = "failover"
+
+  triggers = {
+    game_day = "2024-06-01"
+  }
+
+  timeouts {
+    create = "1h"
+  }
+}
@@ -0,0 +1,12 @@
+resource "crunchybridge_cluster_parameters" "tuning" {
+  cluster_id = var.example_id
+
+  parameters = {
+    work_mem                   = "64MB"
+    max_connections            = "200"
+    log_min_duration_statement = "500"
+  }
+
+  # max_connections requires a restart to take effect
+  wait_for_restart = true
+}
@@ -0,0 +1,2 @@
+# Replicas are imported using the replica's cluster ID
+terraform import crunchybridge_cluster_replica.reporting <replica_id>
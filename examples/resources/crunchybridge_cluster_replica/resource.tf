@@ -0,0 +1,7 @@
+resource "crunchybridge_cluster_replica" "reporting" {
+  source_cluster_id = var.example_id
+  name              = "reporting-replica"
+  plan_id           = "standard-8"
+  region_id         = "us-east-1"
+  wait_until_ready  = true
+}
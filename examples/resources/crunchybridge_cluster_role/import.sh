@@ -0,0 +1,2 @@
+# Cluster roles are imported using the cluster ID and the role name
+terraform import crunchybridge_cluster_role.me <cluster_id>/<role_name>
@@ -0,0 +1,8 @@
+resource "crunchybridge_cluster_role" "me" {
+  cluster_id = var.example_id
+}
+
+output "my_role_uri" {
+  value     = crunchybridge_cluster_role.me.uri
+  sensitive = true
+}
@@ -0,0 +1,10 @@
+resource "crunchybridge_cluster_role_rotation" "application" {
+  cluster_id    = var.example_id
+  role_name     = "application"
+  rotation_days = 90
+}
+
+output "application_uri" {
+  value     = crunchybridge_cluster_role_rotation.application.uri
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# Firewall rules are imported using the network ID and the rule ID
+terraform import crunchybridge_firewall_rule.office <network_id>/<rule_id>
@@ -0,0 +1,5 @@
+resource "crunchybridge_firewall_rule" "office" {
+  cluster_id  = var.example_id
+  cidr        = "203.0.113.0/24"
+  description = "Office network"
+}
@@ -0,0 +1,2 @@
+# Log destinations are imported using the cluster ID and the destination ID
+terraform import crunchybridge_log_destination.papertrail <cluster_id>/<destination_id>
@@ -0,0 +1,11 @@
+variable "papertrail_host" {
+  type = string
+}
+
+resource "crunchybridge_log_destination" "papertrail" {
+  cluster_id  = var.example_id
+  host        = var.papertrail_host
+  port        = 12345
+  template    = "<$${PRI}>1 $${ISODATE} $${HOST} $${PROGRAM} $${PID} - - $${MSG}\n"
+  description = "Papertrail"
+}
@@ -0,0 +1,2 @@
+# Networks are imported using the network ID
+terraform import crunchybridge_network.shared <network_id>
@@ -0,0 +1,15 @@
+resource "crunchybridge_network" "shared" {
+  team_id     = var.example_id
+  name        = "shared-network"
+  provider_id = "aws"
+  region_id   = "us-west-1"
+  cidr        = "10.10.0.0/16"
+}
+
+resource "crunchybridge_cluster" "db" {
+  team_id     = var.example_id
+  name        = "db-on-shared-network"
+  provider_id = crunchybridge_network.shared.provider_id
+  region_id   = crunchybridge_network.shared.region_id
+  network_id  = crunchybridge_network.shared.id
+}
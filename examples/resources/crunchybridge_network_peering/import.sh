@@ -0,0 +1,2 @@
+# Network peerings are imported using the network ID and the peering ID
+terraform import crunchybridge_network_peering.app <network_id>/<peering_id>
@@ -0,0 +1,12 @@
+resource "crunchybridge_network_peering" "app" {
+  network_id      = var.example_id
+  peer_account_id = "123456789012"
+  peer_vpc_id     = "vpc-0123456789abcdef0"
+  peer_cidr       = "172.31.0.0/16"
+}
+
+# Accept the peering on the AWS side
+resource "aws_vpc_peering_connection_accepter" "bridge" {
+  vpc_peering_connection_id = crunchybridge_network_peering.app.peering_identifier
+  auto_accept               = true
+}
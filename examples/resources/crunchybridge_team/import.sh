@@ -0,0 +1,2 @@
+# Teams are imported using the team ID
+terraform import crunchybridge_team.analytics <team_id>
@@ -0,0 +1,8 @@
+resource "crunchybridge_team" "analytics" {
+  name = "Analytics"
+}
+
+resource "crunchybridge_cluster" "warehouse" {
+  team_id = crunchybridge_team.analytics.id
+  name    = "analytics-warehouse"
+}
@@ -0,0 +1,2 @@
+# Team members are imported using the team ID and the member ID
+terraform import crunchybridge_team_member.alex <team_id>/<member_id>
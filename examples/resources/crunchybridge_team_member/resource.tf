@@ -0,0 +1,5 @@
+resource "crunchybridge_team_member" "alex" {
+  team_id = var.example_id
+  email   = "alex@example.com"
+  role    = "manager"
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateAPIKey creates an API key for the authenticated account. The returned
+// key holds the `cbkey_` secret, which the API never returns again.
+func (c *Client) CreateAPIKey(kr APIKeyCreateRequest) (APIKey, error) {
+	if err := c.login(); err != nil {
+		return APIKey{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, routeAPIKeys)
+
+	reqPayload, err := json.Marshal(kr)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("error during API key request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return APIKey{}, fmt.Errorf("during API key create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("during API key create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusBadRequest {
+			return APIKey{}, fmt.Errorf("API key bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		}
+		return APIKey{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	var key APIKey
+	err = json.NewDecoder(resp.Body).Decode(&key)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("error unmarshaling response body (API key create): %w", err)
+	}
+
+	return key, nil
+}
+
+// APIKey fetches a single API key without its secret, wrapping ErrorNotFound
+// when it doesn't exist
+func (c *Client) APIKey(id string) (APIKey, error) {
+	if err := c.login(); err != nil {
+		return APIKey{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeAPIKeys, id)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("during API key detail request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("during API key detail request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return APIKey{}, fmt.Errorf("API key [%s] %w", id, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return APIKey{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var key APIKey
+	err = json.NewDecoder(resp.Body).Decode(&key)
+	if err != nil {
+		return APIKey{}, fmt.Errorf("error unmarshaling response body (API key detail): %w", err)
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey deletes the API key so that it can no longer authenticate
+func (c *Client) RevokeAPIKey(id string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeAPIKeys, id)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during API key delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during API key delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ClusterBackups lists the cluster's base backups, including any in progress
+func (c *Client) ClusterBackups(clusterID string) ([]ClusterBackup, error) {
+	if err := c.login(); err != nil {
+		return []ClusterBackup{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeBackups, clusterID))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []ClusterBackup{}, fmt.Errorf("during cluster backup list request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []ClusterBackup{}, fmt.Errorf("during cluster backup list request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return []ClusterBackup{}, fmt.Errorf("cluster [%s] %w", clusterID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return []ClusterBackup{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]ClusterBackup{
+		"backups": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []ClusterBackup{}, fmt.Errorf("error unmarshaling response body (cluster backup list): %w", err)
+	}
+
+	list := response["backups"]
+	return list, nil
+}
+
+// ClusterBackup finds a single backup of the cluster by name, wrapping
+// ErrorNotFound when either doesn't exist
+func (c *Client) ClusterBackup(clusterID, name string) (ClusterBackup, error) {
+	backups, err := c.ClusterBackups(clusterID)
+	if err != nil {
+		return ClusterBackup{}, err
+	}
+
+	for _, backup := range backups {
+		if backup.Name == name {
+			return backup, nil
+		}
+	}
+
+	return ClusterBackup{}, fmt.Errorf("cluster backup [%s] %w", name, ErrorNotFound)
+}
+
+// StartClusterBackup starts a base backup of the cluster, returning the backup
+// while it is still in progress
+func (c *Client) StartClusterBackup(clusterID string) (ClusterBackup, error) {
+	if err := c.login(); err != nil {
+		return ClusterBackup{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeBackups, clusterID))
+
+	req, err := http.NewRequest(http.MethodPost, route, nil)
+	if err != nil {
+		return ClusterBackup{}, fmt.Errorf("during cluster backup create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ClusterBackup{}, fmt.Errorf("during cluster backup create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusConflict {
+			// Only one backup runs at a time
+			return ClusterBackup{}, fmt.Errorf("cluster backup conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+		}
+		return ClusterBackup{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	var backup ClusterBackup
+	err = json.NewDecoder(resp.Body).Decode(&backup)
+	if err != nil {
+		return ClusterBackup{}, fmt.Errorf("error unmarshaling response body (cluster backup create): %w", err)
+	}
+
+	return backup, nil
+}
@@ -26,22 +26,31 @@ import (
 	"strings"
 	"sync"
 	"time"
-
-	"github.com/google/uuid"
 )
 
 var (
+	routeAccessTokens  string = "/access-tokens"
 	routeAccount       string = "/account"
+	routeAPIKeys       string = "/api-keys"
+	routeBackups       string = "/clusters/%s/backups"
 	routeClusters      string = "/clusters"
+	routeClusterAction string = "/clusters/%s/actions/%s"
+	routeClusterParams string = "/clusters/%s/configuration-parameters"
 	routeClusterRole   string = "/clusters/%s/roles"
+	routeReplicas      string = "/clusters/%s/replicas"
 	routeClusterStatus string = "/clusters/%s/status"
+	routeClusterTags   string = "/clusters/%s/tags"
+	routeFirewallRules string = "/networks/%s/firewall-rules"
+	routeLoggers       string = "/clusters/%s/loggers"
+	routeNetworks      string = "/networks"
+	routePeerings      string = "/networks/%s/peerings"
 	routeProviders     string = "/providers"
 	routeTeams         string = "/teams"
+	routeTeamCert      string = "/teams/%s.pem"
+	routeTeamMembers   string = "/teams/%s/members"
 )
 
 var (
-	BridgeProviderNS = uuid.MustParse("cc67b0e5-7152-4d54-85ff-49a5c17fbbfe")
-
 	// Maximum time construct for Golang
 	// Unix time uses an offset of 62135596801 to cover pre-start-of-epoch times
 	maxTime = time.Unix(1<<63-62135596801, 999999999)
@@ -56,9 +65,12 @@ type Client struct {
 	apiTarget         *url.URL
 	client            *http.Client
 	credential        Login
+	defaultTags       map[string]string
 	legacyAuth        bool
 	useIdempotencyKey bool
 	userAgent         string
+	tokenCachePath    string
+	tokenCached       bool // active token is shared through the cache
 	tokenExpires      time.Time
 }
 
@@ -129,9 +141,23 @@ func WithContext(ctx context.Context) ClientOption {
 	}
 }
 
-// WithIdempotencyKey causes the client to send an Idempotency Key header on cluster create
-// N.B. This may have unexpected behavior tied to cached responses after system state
-// changes invalidate the correctness of those responses
+// WithDefaultTags sets tags that callers merge into the tags of every cluster
+// they manage, retrievable with DefaultTags
+// Setter - always returns nil error
+func WithDefaultTags(tags map[string]string) ClientOption {
+	return func(c *Client) error {
+		c.defaultTags = make(map[string]string, len(tags))
+		for k, v := range tags {
+			c.defaultTags[k] = v
+		}
+		return nil
+	}
+}
+
+// WithIdempotencyKey causes the client to send an Idempotency Key header on cluster create,
+// using the key provided by the caller, and to retry creates that received no response
+// N.B. Keys must be unique per cluster, reusing a key returns the cached response of the
+// original request even after system state changes invalidate its correctness
 func WithIdempotencyKey() ClientOption {
 	return func(c *Client) error {
 		c.useIdempotencyKey = true
@@ -148,6 +174,17 @@ func WithTokenExchange() ClientOption {
 	}
 }
 
+// WithTokenCache stores exchanged tokens in the file at path so that later
+// clients using the same credential and API target can reuse them until they
+// near expiration. Only applies alongside WithTokenExchange.
+// Setter - always returns nil error
+func WithTokenCache(path string) ClientOption {
+	return func(c *Client) error {
+		c.tokenCachePath = path
+		return nil
+	}
+}
+
 func (c *Client) login() error {
 	// No-op if already logged in, maybe add forced login later for error handling
 	c.RLock()
@@ -158,7 +195,36 @@ func (c *Client) login() error {
 	}
 
 	if c.legacyAuth {
-		req, err := http.NewRequest(http.MethodPost, c.apiTarget.String()+"/access-tokens", nil)
+		useCache := c.tokenCachePath != ""
+		if useCache {
+			// Held through the exchange, so that concurrent clients reuse one
+			// token rather than replacing each other's entries
+			unlock, err := lockTokenCache(c.tokenCachePath)
+			if err != nil {
+				// The cache only saves an exchange, so carry on without it
+				fmt.Fprintf(os.Stderr, "token cache unavailable: %s", err)
+				useCache = false
+			} else {
+				defer unlock()
+			}
+		}
+		if useCache {
+			entry, ok, err := c.cachedToken()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "token cache unavailable: %s", err)
+				useCache = false
+			} else if ok {
+				c.Lock()
+				c.activeToken = entry.Token
+				c.activeTokenID = entry.TokenID
+				c.tokenCached = true
+				c.tokenExpires = entry.Expires
+				c.Unlock()
+				return nil
+			}
+		}
+
+		req, err := http.NewRequest(http.MethodPost, c.apiTarget.String()+routeAccessTokens, nil)
 		if err != nil {
 			return fmt.Errorf("error creating token login request: %w", err)
 		}
@@ -188,7 +254,21 @@ func (c *Client) login() error {
 
 		c.activeToken = tr.Token
 		c.activeTokenID = tr.TokenID
+		c.tokenCached = false
 		c.tokenExpires = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+
+		if useCache {
+			err = c.cacheToken(tokenCacheEntry{
+				Expires: c.tokenExpires,
+				Token:   c.activeToken,
+				TokenID: c.activeTokenID,
+			})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to cache token: %s", err)
+			} else {
+				c.tokenCached = true
+			}
+		}
 	} else {
 		if !strings.HasPrefix(c.credential.Secret, "cbkey_") {
 			return ErrorOldSecretFormat
@@ -212,19 +292,39 @@ func (c *Client) logout() error {
 		return nil
 	}
 
-	route := fmt.Sprintf("%s%s/%s", c.apiTarget, "/access-tokens", c.activeTokenID)
-
-	req, err := http.NewRequest(http.MethodDelete, route, nil)
-	if err != nil {
-		return fmt.Errorf("error creating token delete request: %w", err)
+	// Cached tokens may be in use by other processes sharing the cache, so
+	// they're left to expire on their own
+	if c.tokenCached {
+		return nil
 	}
-	// Ensure lock occurs after here, since obtaining RLock will fail/deadlock otherwise
-	c.setCommonHeaders(req)
 
 	// Ensure only one attempting to delete token
 	c.Lock()
 	defer c.Unlock()
 
+	if err := c.deleteToken(c.activeToken, c.activeTokenID); err != nil {
+		return err
+	}
+
+	c.activeToken = ""
+	c.activeTokenID = ""
+	c.tokenExpires = time.Now().Add(-1 * time.Second) // move to clear < 0 range of comparison
+
+	return nil
+}
+
+// deleteToken invalidates an access token, authenticating with the token itself
+// since it may not be the client's active token
+func (c *Client) deleteToken(token, tokenID string) error {
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeAccessTokens, tokenID)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("error creating token delete request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	c.setRequestUserAgent(req)
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return fmt.Errorf("error submitting delete request: %w", err)
@@ -232,16 +332,21 @@ func (c *Client) logout() error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API returned unexpected response %d for login [%s]", resp.StatusCode, c.credential.Key)
+		return fmt.Errorf("API returned unexpected response %d for logout [%s]", resp.StatusCode, c.credential.Key)
 	}
 
-	c.activeToken = ""
-	c.activeTokenID = ""
-	c.tokenExpires = time.Now().Add(-1 * time.Second) // move to clear < 0 range of comparison
-
 	return nil
 }
 
+// DefaultTags returns a copy of the tags configured with WithDefaultTags
+func (c *Client) DefaultTags() map[string]string {
+	tags := make(map[string]string, len(c.defaultTags))
+	for k, v := range c.defaultTags {
+		tags[k] = v
+	}
+	return tags
+}
+
 // Close allows an explicit request to log out of the current session
 // There is no explicit login, as login is triggered for every client call
 // to ensure an active session state.
@@ -17,15 +17,25 @@ package bridgeapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
+)
 
-	"github.com/google/uuid"
+const (
+	// Retry settings for cluster create requests which never received a
+	// response, only used when an idempotency key accompanies the request
+	createAttempts   = 3
+	createRetryDelay = 5 * time.Second
 )
 
-func (c *Client) CreateCluster(cr CreateRequest) (string, error) {
+// CreateCluster submits a cluster create request. When the client is configured
+// WithIdempotencyKey, idemKey is sent as the Idempotency-Key header and requests
+// that fail without a response are retried using the same key until ctx is done.
+func (c *Client) CreateCluster(ctx context.Context, cr CreateRequest, idemKey string) (string, error) {
 	if err := c.login(); err != nil {
 		return "", err
 	}
@@ -34,25 +44,42 @@ func (c *Client) CreateCluster(cr CreateRequest) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("error during cluser request encoding: %w", err)
 	}
-	req, err := http.NewRequest(http.MethodPost, c.apiTarget.String()+routeClusters, bytes.NewReader(reqPayload))
-	if err != nil {
-		return "", fmt.Errorf("during create cluster request: %w", err)
+
+	// Without a key, retrying risks creating a duplicate cluster
+	useKey := c.useIdempotencyKey && idemKey != ""
+	attempts := 1
+	if useKey {
+		attempts = createAttempts
 	}
 
-	c.setCommonHeaders(req)
+	var resp *http.Response
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequest(http.MethodPost, c.apiTarget.String()+routeClusters, bytes.NewReader(reqPayload))
+		if err != nil {
+			return "", fmt.Errorf("during create cluster request: %w", err)
+		}
 
-	// Set Idempotency Key based on payload content
-	//
-	// API is expecting UUID for the value, but we're using UUIDv5 so that
-	// the key matches the request payload
-	if c.useIdempotencyKey {
-		idemKey := uuid.NewSHA1(BridgeProviderNS, reqPayload)
-		req.Header.Set("Idempotency-Key", idemKey.String())
-	}
+		c.setCommonHeaders(req)
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("during create cluster: %w", err)
+		// API is expecting UUID for the value, the caller is responsible for
+		// keeping the key stable for the lifetime of the resource it creates
+		if useKey {
+			req.Header.Set("Idempotency-Key", idemKey)
+		}
+
+		resp, err = c.client.Do(req)
+		if err == nil {
+			break
+		} else if attempt >= attempts {
+			// The request may have reached the API before the failure occurred
+			return "", fmt.Errorf("during create cluster %w: %s", ErrorAmbiguous, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("during create cluster %w: %s, retry cancelled: %s", ErrorAmbiguous, err, ctx.Err())
+		case <-time.After(createRetryDelay):
+		}
 	}
 	defer resp.Body.Close()
 
@@ -74,7 +101,7 @@ func (c *Client) CreateCluster(cr CreateRequest) (string, error) {
 		}
 		err = json.NewDecoder(resp.Body).Decode(&idOnly)
 		if err != nil {
-			return "", fmt.Errorf("unable to retrieve cluster ID from successful create response: %w", err)
+			return "", fmt.Errorf("unable to retrieve cluster ID from successful create response %w: %s", ErrorAmbiguous, err)
 		} else {
 			return idOnly.ID, nil
 		}
@@ -82,6 +109,9 @@ func (c *Client) CreateCluster(cr CreateRequest) (string, error) {
 		return "", fmt.Errorf("create API bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
 	case http.StatusConflict:
 		return "", fmt.Errorf("create API conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		// Server-side failures don't guarantee the create was not accepted
+		return "", fmt.Errorf("create API server error %w, code: %d, message: %s", ErrorAmbiguous, resp.StatusCode, mesg.Message)
 	default:
 		return "", fmt.Errorf("unrecognized return status from create call, code: %d, message: %s", resp.StatusCode, mesg.Message)
 	}
@@ -132,7 +162,9 @@ func (c *Client) ClusterDetail(id string) (ClusterDetail, error) {
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode == http.StatusNotFound {
+		return ClusterDetail{}, fmt.Errorf("cluster [%s] %w", id, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
 		return ClusterDetail{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
 	}
 
@@ -145,6 +177,55 @@ func (c *Client) ClusterDetail(id string) (ClusterDetail, error) {
 	return detail, nil
 }
 
+// CreateReplica creates a read replica of the source cluster, returning the new
+// replica. Fields left empty in the request default to those of the source.
+func (c *Client) CreateReplica(sourceID string, rr ReplicaCreateRequest) (ClusterDetail, error) {
+	if err := c.login(); err != nil {
+		return ClusterDetail{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeReplicas, sourceID))
+
+	reqPayload, err := json.Marshal(rr)
+	if err != nil {
+		return ClusterDetail{}, fmt.Errorf("error during replica request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return ClusterDetail{}, fmt.Errorf("during replica create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ClusterDetail{}, fmt.Errorf("during replica create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			return ClusterDetail{}, fmt.Errorf("replica create bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		case http.StatusConflict:
+			return ClusterDetail{}, fmt.Errorf("replica create conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+		default:
+			return ClusterDetail{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+		}
+	}
+
+	var detail ClusterDetail
+	err = json.NewDecoder(resp.Body).Decode(&detail)
+	if err != nil {
+		return ClusterDetail{}, fmt.Errorf("error unmarshaling response body (replica create): %w", err)
+	}
+
+	return detail, nil
+}
+
 func (c *Client) ClusterStatus(id string) (ClusterStatus, error) {
 	if err := c.login(); err != nil {
 		return ClusterStatus{}, err
@@ -178,45 +259,6 @@ func (c *Client) ClusterStatus(id string) (ClusterStatus, error) {
 	return status, nil
 }
 
-func (c *Client) ClusterRoles(id string) ([]ClusterRole, error) {
-	if err := c.login(); err != nil {
-		return []ClusterRole{}, err
-	}
-
-	roleRoute := fmt.Sprintf(routeClusterRole, id)
-	roles := []string{"postgres", "application"}
-
-	defaultRoles := make([]ClusterRole, 0, 2)
-	for _, role := range roles {
-		route := fmt.Sprintf("%s%s/%s", c.apiTarget, roleRoute, role)
-
-		req, err := http.NewRequest(http.MethodGet, route, nil)
-		if err != nil {
-			return []ClusterRole{}, fmt.Errorf("during cluster role [%s] request: %w", role, err)
-		}
-		c.setCommonHeaders(req)
-
-		resp, err := c.client.Do(req)
-		if err != nil {
-			return []ClusterRole{}, fmt.Errorf("during cluster role [%s] request prep: %w", role, err)
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			return []ClusterRole{}, fmt.Errorf("unexpected response status from API, role: %s, status: %d", role, resp.StatusCode)
-		}
-
-		var roleInfo ClusterRole
-		err = json.NewDecoder(resp.Body).Decode(&roleInfo)
-		if err != nil {
-			return []ClusterRole{}, fmt.Errorf("error unmarshaling response body (cluster role: %s): %w", role, err)
-		}
-		defaultRoles = append(defaultRoles, roleInfo)
-	}
-
-	return defaultRoles, nil
-}
-
 func (c *Client) ClustersForTeam(team_id string) ([]ClusterDetail, error) {
 	if err := c.login(); err != nil {
 		return []ClusterDetail{}, err
@@ -334,3 +376,109 @@ func (c *Client) UpgradeCluster(id string, ur ClusterUpgradeRequest) error {
 
 	return nil
 }
+
+// SetClusterTags replaces every tag on the cluster with the given tags
+func (c *Client) SetClusterTags(id string, tags map[string]string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeClusterTags, id))
+
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	reqPayload, err := json.Marshal(map[string]map[string]string{
+		"tags": tags,
+	})
+	if err != nil {
+		return fmt.Errorf("error during cluster tags encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return fmt.Errorf("during cluster tags request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during cluster tags request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusBadRequest {
+			return fmt.Errorf("cluster tags bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		}
+		return fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	return nil
+}
+
+// SuspendCluster stops the cluster's instances while keeping its storage. The
+// transition happens in the background, ending in the suspended state.
+func (c *Client) SuspendCluster(id string) error {
+	return c.clusterAction(id, "suspend")
+}
+
+// ResumeCluster starts a suspended cluster. The transition happens in the
+// background, ending in the ready state.
+func (c *Client) ResumeCluster(id string) error {
+	return c.clusterAction(id, "resume")
+}
+
+// RestartCluster restarts Postgres on the cluster, applying any parameters
+// pending a restart. The cluster returns to the ready state once done.
+func (c *Client) RestartCluster(id string) error {
+	return c.clusterAction(id, "restart")
+}
+
+// FailoverCluster promotes the standby of a high availability cluster to be
+// its primary. The cluster returns to the ready state once done.
+func (c *Client) FailoverCluster(id string) error {
+	return c.clusterAction(id, "failover")
+}
+
+// clusterAction requests the named action on a cluster
+func (c *Client) clusterAction(id, action string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeClusterAction, id, action))
+
+	req, err := http.NewRequest(http.MethodPut, route, nil)
+	if err != nil {
+		return fmt.Errorf("during cluster %s request: %w", action, err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during cluster %s request prep: %w", action, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			return fmt.Errorf("cluster %s bad request message %w: %s, request_id: %s", action, ErrorBadRequest, mesg.Message, mesg.RequestID)
+		case http.StatusConflict:
+			return fmt.Errorf("cluster %s conflict message %w: %s, request_id: %s", action, ErrorConflict, mesg.Message, mesg.RequestID)
+		case http.StatusNotFound:
+			return fmt.Errorf("cluster [%s] %w", id, ErrorNotFound)
+		}
+		return fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	return nil
+}
@@ -20,6 +20,11 @@ import "errors"
 var (
 	ErrorBadRequest = errors.New("invalid request")
 	ErrorConflict   = errors.New("non-unique name specified in request")
+	ErrorNotFound   = errors.New("not found")
+
+	// ErrorAmbiguous indicates the API may have accepted a request even though
+	// the client could not confirm it, e.g. the connection dropped mid-request
+	ErrorAmbiguous = errors.New("request outcome could not be determined")
 
 	ErrorOldSecretFormat = errors.New("unexpected format for api secret, regeneration may be needed")
 )
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// FirewallRules lists the rules allowing access to clusters in the network
+func (c *Client) FirewallRules(networkID string) ([]FirewallRule, error) {
+	if err := c.login(); err != nil {
+		return []FirewallRule{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeFirewallRules, networkID))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []FirewallRule{}, fmt.Errorf("during firewall rule list request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []FirewallRule{}, fmt.Errorf("during firewall rule list request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return []FirewallRule{}, fmt.Errorf("network [%s] %w", networkID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return []FirewallRule{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]FirewallRule{
+		"firewall_rules": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []FirewallRule{}, fmt.Errorf("error unmarshaling response body (firewall rule list): %w", err)
+	}
+
+	list := response["firewall_rules"]
+	return list, nil
+}
+
+// FirewallRule finds a single rule in the network, wrapping ErrorNotFound when
+// either doesn't exist
+func (c *Client) FirewallRule(networkID, ruleID string) (FirewallRule, error) {
+	rules, err := c.FirewallRules(networkID)
+	if err != nil {
+		return FirewallRule{}, err
+	}
+
+	for _, rule := range rules {
+		if rule.ID == ruleID {
+			return rule, nil
+		}
+	}
+
+	return FirewallRule{}, fmt.Errorf("firewall rule [%s] %w", ruleID, ErrorNotFound)
+}
+
+func (c *Client) CreateFirewallRule(networkID string, fr FirewallRuleRequest) (FirewallRule, error) {
+	if err := c.login(); err != nil {
+		return FirewallRule{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeFirewallRules, networkID))
+
+	reqPayload, err := json.Marshal(fr)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("error during firewall rule request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("during firewall rule create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("during firewall rule create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusBadRequest {
+			return FirewallRule{}, fmt.Errorf("firewall rule bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		}
+		return FirewallRule{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	var rule FirewallRule
+	err = json.NewDecoder(resp.Body).Decode(&rule)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("error unmarshaling response body (firewall rule create): %w", err)
+	}
+
+	return rule, nil
+}
+
+func (c *Client) UpdateFirewallRule(networkID, ruleID string, fr FirewallRuleRequest) (FirewallRule, error) {
+	if err := c.login(); err != nil {
+		return FirewallRule{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeFirewallRules, networkID), ruleID)
+
+	reqPayload, err := json.Marshal(fr)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("error during firewall rule request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("during firewall rule update request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("during firewall rule update request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return FirewallRule{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var rule FirewallRule
+	err = json.NewDecoder(resp.Body).Decode(&rule)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("error unmarshaling response body (firewall rule update): %w", err)
+	}
+
+	return rule, nil
+}
+
+func (c *Client) DeleteFirewallRule(networkID, ruleID string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeFirewallRules, networkID), ruleID)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during firewall rule delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during firewall rule delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// LogDestinations lists the syslog endpoints the cluster's logs are shipped to
+func (c *Client) LogDestinations(clusterID string) ([]LogDestination, error) {
+	if err := c.login(); err != nil {
+		return []LogDestination{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeLoggers, clusterID))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []LogDestination{}, fmt.Errorf("during log destination list request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []LogDestination{}, fmt.Errorf("during log destination list request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return []LogDestination{}, fmt.Errorf("cluster [%s] %w", clusterID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return []LogDestination{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]LogDestination{
+		"loggers": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []LogDestination{}, fmt.Errorf("error unmarshaling response body (log destination list): %w", err)
+	}
+
+	list := response["loggers"]
+	return list, nil
+}
+
+// LogDestination finds a single log destination of the cluster, wrapping
+// ErrorNotFound when either doesn't exist
+func (c *Client) LogDestination(clusterID, destinationID string) (LogDestination, error) {
+	destinations, err := c.LogDestinations(clusterID)
+	if err != nil {
+		return LogDestination{}, err
+	}
+
+	for _, destination := range destinations {
+		if destination.ID == destinationID {
+			return destination, nil
+		}
+	}
+
+	return LogDestination{}, fmt.Errorf("log destination [%s] %w", destinationID, ErrorNotFound)
+}
+
+func (c *Client) CreateLogDestination(clusterID string, lr LogDestinationRequest) (LogDestination, error) {
+	if err := c.login(); err != nil {
+		return LogDestination{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeLoggers, clusterID))
+
+	reqPayload, err := json.Marshal(lr)
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("error during log destination request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("during log destination create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("during log destination create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusBadRequest {
+			return LogDestination{}, fmt.Errorf("log destination bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		}
+		return LogDestination{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	var destination LogDestination
+	err = json.NewDecoder(resp.Body).Decode(&destination)
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("error unmarshaling response body (log destination create): %w", err)
+	}
+
+	return destination, nil
+}
+
+func (c *Client) UpdateLogDestination(clusterID, destinationID string, lr LogDestinationRequest) (LogDestination, error) {
+	if err := c.login(); err != nil {
+		return LogDestination{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeLoggers, clusterID), destinationID)
+
+	reqPayload, err := json.Marshal(lr)
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("error during log destination request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("during log destination update request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("during log destination update request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return LogDestination{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var destination LogDestination
+	err = json.NewDecoder(resp.Body).Decode(&destination)
+	if err != nil {
+		return LogDestination{}, fmt.Errorf("error unmarshaling response body (log destination update): %w", err)
+	}
+
+	return destination, nil
+}
+
+func (c *Client) DeleteLogDestination(clusterID, destinationID string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeLoggers, clusterID), destinationID)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during log destination delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during log destination delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -15,17 +15,34 @@ limitations under the License.
 */
 package bridgeapi
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type CreateRequest struct {
-	Name             string `json:"name"`
-	TeamID           string `json:"team_id"`
-	Plan             string `json:"plan_id"`
-	StorageGB        int    `json:"storage"`
-	Provider         string `json:"provider_id"`
-	Region           string `json:"region_id"`
-	PGMajorVersion   int    `json:"postgres_version_id"`
-	HighAvailability bool   `json:"is_ha"`
+	Name             string            `json:"name"`
+	TeamID           string            `json:"team_id"`
+	Plan             string            `json:"plan_id"`
+	StorageGB        int               `json:"storage"`
+	Provider         string            `json:"provider_id"`
+	Region           string            `json:"region_id"`
+	PGMajorVersion   int               `json:"postgres_version_id"`
+	HighAvailability bool              `json:"is_ha"`
+	NetworkID        string            `json:"network_id,omitempty"` // API creates a network when not provided
+	Tags             map[string]string `json:"tags,omitempty"`
+
+	// Forks and point-in-time restores of an existing cluster
+	SourceClusterID    string     `json:"source_cluster_id,omitempty"`
+	RecoveryTargetTime *time.Time `json:"target_time,omitempty"` // latest point when nil
+}
+
+type ReplicaCreateRequest struct {
+	Name      string `json:"name,omitempty"`
+	Plan      string `json:"plan_id,omitempty"`
+	Provider  string `json:"provider_id,omitempty"`
+	Region    string `json:"region_id,omitempty"`
+	StorageGB int    `json:"storage,omitempty"`
 }
 
 type ClusterList struct {
@@ -33,28 +50,32 @@ type ClusterList struct {
 }
 
 type ClusterDetail struct {
-	CPU              int       `json:"cpu"`
-	Created          time.Time `json:"created_at"`
-	ID               string    `json:"id"`
-	HighAvailability bool      `json:"is_ha"`
-	PGMajorVersion   int       `json:"major_version"`
-	MaintWindowStart int       `json:"maintenance_window_start"`
-	MemoryGB         float64   `json:"memory"` // 64 precision isn't required, but likely default arch
-	Name             string    `json:"name"`
-	PlanID           string    `json:"plan_id"`
-	ProviderID       string    `json:"provider_id"`
-	RegionID         string    `json:"region_id"`
-	State            string    `json:"state"` // NOTE: Deprecated, but using to avoid extra status call on sync create for now
-	StorageGB        int       `json:"storage"`
-	TeamID           string    `json:"team_id"`
-	Updated          time.Time `json:"updated_at"`
+	CPU              int               `json:"cpu"`
+	Created          time.Time         `json:"created_at"`
+	ID               string            `json:"id"`
+	HighAvailability bool              `json:"is_ha"`
+	PGMajorVersion   int               `json:"major_version"`
+	MaintWindowStart *int              `json:"maintenance_window_start"`
+	MemoryGB         float64           `json:"memory"` // 64 precision isn't required, but likely default arch
+	Name             string            `json:"name"`
+	NetworkID        string            `json:"network_id"`
+	ParentID         *string           `json:"parent_id"` // nil unless the cluster is a replica
+	PlanID           string            `json:"plan_id"`
+	ProviderID       string            `json:"provider_id"`
+	RegionID         string            `json:"region_id"`
+	Replicas         []ClusterDetail   `json:"replicas"`
+	State            ClusterState      `json:"state"` // NOTE: Deprecated, but using to avoid extra status call on sync create for now
+	StorageGB        int               `json:"storage"`
+	Tags             map[string]string `json:"tags"`
+	TeamID           string            `json:"team_id"`
+	Updated          time.Time         `json:"updated_at"`
 }
 
 type ClusterStatus struct {
 	DiskUsage      ClusterDiskUsage `json:"disk_usage"`
-	OldestBackup   time.Time        `json:"oldest_backup_at"`
+	OldestBackup   *time.Time       `json:"oldest_backup_at"` // nil until a backup completes
 	OngoingUpgrade ClusterUpgrade   `json:"ongoing_upgrade"`
-	State          string           `json:"state"`
+	State          ClusterState     `json:"state"`
 }
 
 type ClusterDiskUsage struct {
@@ -68,15 +89,29 @@ type ClusterUpgrade struct {
 }
 
 type ClusterUpgradeOperation struct {
-	Flavor string `json:"flavor"`
-	State  string `json:"state"`
+	Flavor UpgradeFlavor `json:"flavor"`
+	State  UpgradeState  `json:"state"`
 }
 
 type ClusterUpdateRequest struct {
+	ClearMaintWindow bool    `json:"-"` // sends an explicit null, removing the maintenance window
 	MaintWindowStart *int    `json:"maintenance_window_start,omitempty"`
 	Name             *string `json:"name,omitempty"`
 }
 
+// MarshalJSON leaves unset fields out of the request, except for the maintenance
+// window which is sent as null when ClearMaintWindow is set
+func (ur ClusterUpdateRequest) MarshalJSON() ([]byte, error) {
+	type plain ClusterUpdateRequest
+	if !ur.ClearMaintWindow {
+		return json.Marshal(plain(ur))
+	}
+	return json.Marshal(struct {
+		plain
+		MaintWindowStart *int `json:"maintenance_window_start"`
+	}{plain: plain(ur)})
+}
+
 type ClusterUpgradeRequest struct {
 	HighAvailability *bool   `json:"is_ha,omitempty"`
 	PGMajorVersion   *int    `json:"postgres_version_id,omitempty"`
@@ -84,12 +119,116 @@ type ClusterUpgradeRequest struct {
 	StorageGB        *int    `json:"storage,omitempty"`
 }
 
+type ClusterBackup struct {
+	Name      string     `json:"name"`
+	Finished  *time.Time `json:"finished_at"` // nil while the backup is in progress
+	LSNStart  string     `json:"lsn_start"`
+	LSNStop   string     `json:"lsn_stop"`
+	SizeBytes int64      `json:"size_bytes"`
+	Started   time.Time  `json:"started_at"`
+}
+
+type ClusterParameter struct {
+	Name           string `json:"name"`
+	PendingRestart bool   `json:"pending_restart"` // set until a restart applies the value
+	Value          string `json:"value"`
+}
+
+type ClusterParameterUpdate struct {
+	Name  string  `json:"name"`
+	Value *string `json:"value"` // nil resets the parameter to its default
+}
+
 type ClusterRole struct {
-	ClusterID string `json:"cluster_id"`
-	Name      string `json:"name"`
-	Password  string `json:"password"`
-	TeamID    string `json:"team_id"`
-	URI       string `json:"uri"`
+	AccountID *string `json:"account_id"` // nil for system roles
+	ClusterID string  `json:"cluster_id"`
+	Name      string  `json:"name"`
+	Password  string  `json:"password"`
+	TeamID    string  `json:"team_id"`
+	URI       string  `json:"uri"`
+}
+
+type ClusterRoleUpdateRequest struct {
+	RotatePassword *bool `json:"rotate_password,omitempty"`
+}
+
+type FirewallRule struct {
+	ID          string  `json:"id"`
+	Description *string `json:"description"`
+	Rule        string  `json:"rule"` // CIDR block allowed to connect
+}
+
+type FirewallRuleRequest struct {
+	Description *string `json:"description,omitempty"`
+	Rule        string  `json:"rule"`
+}
+
+type LogDestination struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Template    string `json:"template"` // syslog message template
+}
+
+type LogDestinationRequest struct {
+	Description string `json:"description"`
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Template    string `json:"template"`
+}
+
+type Network struct {
+	ID         string `json:"id"`
+	CIDR4      string `json:"cidr4"`
+	Name       string `json:"name"`
+	ProviderID string `json:"provider_id"`
+	RegionID   string `json:"region_id"`
+	TeamID     string `json:"team_id"`
+}
+
+type NetworkCreateRequest struct {
+	CIDR4      string `json:"cidr4,omitempty"`
+	Name       string `json:"name"`
+	ProviderID string `json:"provider_id"`
+	RegionID   string `json:"region_id"`
+	TeamID     string `json:"team_id"`
+}
+
+type NetworkUpdateRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type NetworkPeering struct {
+	ID                string        `json:"id"`
+	NetworkID         string        `json:"network_id"`
+	NetworkIdentifier string        `json:"network_identifier"` // Bridge side VPC or VNet
+	PeerAccountID     string        `json:"peer_account_id"`
+	PeerCIDR4         string        `json:"peer_cidr4"`
+	PeerRegionID      string        `json:"peer_region_id"`
+	PeerVPCID         string        `json:"peer_vpc_id"`
+	PeeringIdentifier *string       `json:"peering_identifier"` // nil until provisioned
+	Status            PeeringStatus `json:"status"`
+}
+
+type NetworkPeeringCreateRequest struct {
+	PeerAccountID string `json:"peer_account_id"`
+	PeerCIDR4     string `json:"peer_cidr4"`
+	PeerRegionID  string `json:"peer_region_id,omitempty"` // defaults to the network's region
+	PeerVPCID     string `json:"peer_vpc_id"`
+}
+
+type APIKey struct {
+	ID      string     `json:"id"`
+	Created time.Time  `json:"created_at"`
+	Expires *time.Time `json:"expires_at"` // nil for keys that don't expire
+	Key     string     `json:"key"`        // only returned on create
+	Name    string     `json:"name"`
+}
+
+type APIKeyCreateRequest struct {
+	Expires *time.Time `json:"expires_at,omitempty"`
+	Name    string     `json:"name"`
 }
 
 type APIMessage struct {
@@ -98,8 +237,8 @@ type APIMessage struct {
 }
 
 type Account struct {
-	ID            string `json:"id"`
-	DefaultTeamID string `json:"default_team_id"`
+	ID            string  `json:"id"`
+	DefaultTeamID *string `json:"default_team_id"` // nil when the personal team is the default
 }
 
 type Provider struct {
@@ -138,3 +277,28 @@ type Team struct {
 	Name    string `json:"name"`
 	Role    string `json:"role"`
 }
+
+type TeamCreateRequest struct {
+	Name string `json:"name"`
+}
+
+type TeamUpdateRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type TeamMember struct {
+	ID        string `json:"id"`
+	AccountID string `json:"account_id"`
+	Email     string `json:"account_email"`
+	Role      string `json:"role"`
+	TeamID    string `json:"team_id"`
+}
+
+type TeamMemberCreateRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type TeamMemberUpdateRequest struct {
+	Role string `json:"role"`
+}
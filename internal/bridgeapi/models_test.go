@@ -0,0 +1,49 @@
+package bridgeapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestClusterUpdateRequestMarshal(t *testing.T) {
+	name, start := "renamed", 0
+
+	cases := []struct {
+		name string
+		req  ClusterUpdateRequest
+		want string
+	}{
+		{
+			name: "name only",
+			req:  ClusterUpdateRequest{Name: &name},
+			want: `{"name":"renamed"}`,
+		},
+		{
+			name: "midnight window",
+			req:  ClusterUpdateRequest{MaintWindowStart: &start},
+			want: `{"maintenance_window_start":0}`,
+		},
+		{
+			name: "clear midnight window",
+			req:  ClusterUpdateRequest{ClearMaintWindow: true},
+			want: `{"maintenance_window_start":null}`,
+		},
+		{
+			name: "clear window",
+			req:  ClusterUpdateRequest{ClearMaintWindow: true, Name: &name},
+			want: `{"name":"renamed","maintenance_window_start":null}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(tc.req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) CreateNetwork(nr NetworkCreateRequest) (Network, error) {
+	if err := c.login(); err != nil {
+		return Network{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, routeNetworks)
+
+	reqPayload, err := json.Marshal(nr)
+	if err != nil {
+		return Network{}, fmt.Errorf("error during network request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return Network{}, fmt.Errorf("during network create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Network{}, fmt.Errorf("during network create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			return Network{}, fmt.Errorf("network create bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		case http.StatusConflict:
+			return Network{}, fmt.Errorf("network create conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+		default:
+			return Network{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+		}
+	}
+
+	var network Network
+	err = json.NewDecoder(resp.Body).Decode(&network)
+	if err != nil {
+		return Network{}, fmt.Errorf("error unmarshaling response body (network create): %w", err)
+	}
+
+	return network, nil
+}
+
+// Network fetches a single network, wrapping ErrorNotFound when it doesn't exist
+func (c *Client) Network(id string) (Network, error) {
+	if err := c.login(); err != nil {
+		return Network{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeNetworks, id)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return Network{}, fmt.Errorf("during network detail request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Network{}, fmt.Errorf("during network detail request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Network{}, fmt.Errorf("network [%s] %w", id, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return Network{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var network Network
+	err = json.NewDecoder(resp.Body).Decode(&network)
+	if err != nil {
+		return Network{}, fmt.Errorf("error unmarshaling response body (network detail): %w", err)
+	}
+
+	return network, nil
+}
+
+func (c *Client) NetworksForTeam(teamID string) ([]Network, error) {
+	if err := c.login(); err != nil {
+		return []Network{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, routeNetworks)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []Network{}, fmt.Errorf("during get networks request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	params := url.Values{}
+	params.Add("team_id", teamID)
+	req.URL.RawQuery = params.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []Network{}, fmt.Errorf("during get networks request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return []Network{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]Network{
+		"networks": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []Network{}, fmt.Errorf("error unmarshaling response body (get networks): %w", err)
+	}
+
+	list := response["networks"]
+	return list, nil
+}
+
+func (c *Client) UpdateNetwork(id string, ur NetworkUpdateRequest) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeNetworks, id)
+
+	reqPayload, err := json.Marshal(ur)
+	if err != nil {
+		return fmt.Errorf("error during network update encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return fmt.Errorf("during network update request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during network update request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteNetwork(id string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeNetworks, id)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during network delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during network delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
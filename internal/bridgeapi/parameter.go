@@ -0,0 +1,116 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ClusterParameters lists the Postgres configuration parameters that have been
+// set on the cluster
+func (c *Client) ClusterParameters(clusterID string) ([]ClusterParameter, error) {
+	if err := c.login(); err != nil {
+		return []ClusterParameter{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeClusterParams, clusterID))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("during cluster parameter list request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("during cluster parameter list request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return []ClusterParameter{}, fmt.Errorf("cluster [%s] %w", clusterID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return []ClusterParameter{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]ClusterParameter{
+		"parameters": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("error unmarshaling response body (cluster parameter list): %w", err)
+	}
+
+	list := response["parameters"]
+	return list, nil
+}
+
+// UpdateClusterParameters sets or resets the given parameters, leaving any
+// others unchanged, and returns the resulting parameters
+func (c *Client) UpdateClusterParameters(clusterID string, updates []ClusterParameterUpdate) ([]ClusterParameter, error) {
+	if err := c.login(); err != nil {
+		return []ClusterParameter{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeClusterParams, clusterID))
+
+	reqPayload, err := json.Marshal(map[string][]ClusterParameterUpdate{
+		"parameters": updates,
+	})
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("error during cluster parameter update encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("during cluster parameter update request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("during cluster parameter update request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			// Unknown parameters or invalid values
+			return []ClusterParameter{}, fmt.Errorf("cluster parameter bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		case http.StatusNotFound:
+			return []ClusterParameter{}, fmt.Errorf("cluster [%s] %w", clusterID, ErrorNotFound)
+		}
+		return []ClusterParameter{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	response := map[string][]ClusterParameter{
+		"parameters": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []ClusterParameter{}, fmt.Errorf("error unmarshaling response body (cluster parameter update): %w", err)
+	}
+
+	list := response["parameters"]
+	return list, nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateNetworkPeering requests peering between the network and a VPC or VNet in
+// the peer's cloud account, which must then be accepted on the peer side
+func (c *Client) CreateNetworkPeering(networkID string, pr NetworkPeeringCreateRequest) (NetworkPeering, error) {
+	if err := c.login(); err != nil {
+		return NetworkPeering{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routePeerings, networkID))
+
+	reqPayload, err := json.Marshal(pr)
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("error during network peering request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("during network peering create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("during network peering create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			return NetworkPeering{}, fmt.Errorf("network peering bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		case http.StatusConflict:
+			return NetworkPeering{}, fmt.Errorf("network peering conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+		default:
+			return NetworkPeering{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+		}
+	}
+
+	var peering NetworkPeering
+	err = json.NewDecoder(resp.Body).Decode(&peering)
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("error unmarshaling response body (network peering create): %w", err)
+	}
+
+	return peering, nil
+}
+
+// NetworkPeering fetches a single peering, wrapping ErrorNotFound when the
+// network or peering doesn't exist
+func (c *Client) NetworkPeering(networkID, peeringID string) (NetworkPeering, error) {
+	if err := c.login(); err != nil {
+		return NetworkPeering{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routePeerings, networkID), peeringID)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("during network peering detail request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("during network peering detail request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return NetworkPeering{}, fmt.Errorf("network peering [%s] %w", peeringID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return NetworkPeering{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var peering NetworkPeering
+	err = json.NewDecoder(resp.Body).Decode(&peering)
+	if err != nil {
+		return NetworkPeering{}, fmt.Errorf("error unmarshaling response body (network peering detail): %w", err)
+	}
+
+	return peering, nil
+}
+
+func (c *Client) DeleteNetworkPeering(networkID, peeringID string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routePeerings, networkID), peeringID)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during network peering delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during network peering delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
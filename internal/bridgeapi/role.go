@@ -0,0 +1,197 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CreateClusterRole creates the user role (`u_` prefixed) belonging to the
+// authenticated account on the cluster, returning the new role
+func (c *Client) CreateClusterRole(clusterID string) (ClusterRole, error) {
+	if err := c.login(); err != nil {
+		return ClusterRole{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeClusterRole, clusterID))
+
+	req, err := http.NewRequest(http.MethodPost, route, nil)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("during cluster role create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("during cluster role create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return ClusterRole{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var role ClusterRole
+	err = json.NewDecoder(resp.Body).Decode(&role)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("error unmarshaling response body (cluster role create): %w", err)
+	}
+
+	return role, nil
+}
+
+// ClusterRoles lists every role on the cluster, both the system roles
+// (`postgres`, `application`) and any user roles
+func (c *Client) ClusterRoles(id string) ([]ClusterRole, error) {
+	if err := c.login(); err != nil {
+		return []ClusterRole{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeClusterRole, id))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []ClusterRole{}, fmt.Errorf("during cluster role list request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []ClusterRole{}, fmt.Errorf("during cluster role list request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return []ClusterRole{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]ClusterRole{
+		"roles": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []ClusterRole{}, fmt.Errorf("error unmarshaling response body (cluster role list): %w", err)
+	}
+
+	list := response["roles"]
+	return list, nil
+}
+
+// ClusterRole fetches a single role by name, wrapping ErrorNotFound when the
+// cluster or role doesn't exist
+func (c *Client) ClusterRole(clusterID, name string) (ClusterRole, error) {
+	if err := c.login(); err != nil {
+		return ClusterRole{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeClusterRole, clusterID), name)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("during cluster role [%s] request: %w", name, err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("during cluster role [%s] request prep: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ClusterRole{}, fmt.Errorf("cluster role [%s] %w", name, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return ClusterRole{}, fmt.Errorf("unexpected response status from API, role: %s, status: %d", name, resp.StatusCode)
+	}
+
+	var role ClusterRole
+	err = json.NewDecoder(resp.Body).Decode(&role)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("error unmarshaling response body (cluster role: %s): %w", name, err)
+	}
+
+	return role, nil
+}
+
+func (c *Client) DeleteClusterRole(clusterID, name string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeClusterRole, clusterID), name)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during cluster role [%s] delete request: %w", name, err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during cluster role [%s] delete request prep: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected response status from API, role: %s, status: %d", name, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// RotateClusterRolePassword resets the password of the named role, returning the
+// role with its new password and URI
+func (c *Client) RotateClusterRolePassword(clusterID, name string) (ClusterRole, error) {
+	if err := c.login(); err != nil {
+		return ClusterRole{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeClusterRole, clusterID), name)
+
+	rotate := true
+	reqPayload, err := json.Marshal(ClusterRoleUpdateRequest{RotatePassword: &rotate})
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("error during cluster role update encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPut, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("during cluster role [%s] rotate request: %w", name, err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("during cluster role [%s] rotate request prep: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ClusterRole{}, fmt.Errorf("cluster role [%s] %w", name, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return ClusterRole{}, fmt.Errorf("unexpected response status from API, role: %s, status: %d", name, resp.StatusCode)
+	}
+
+	var role ClusterRole
+	err = json.NewDecoder(resp.Body).Decode(&role)
+	if err != nil {
+		return ClusterRole{}, fmt.Errorf("error unmarshaling response body (cluster role: %s): %w", name, err)
+	}
+
+	return role, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+// ClusterState is the lifecycle state reported for a cluster. The API may add
+// states over time, so values outside of the known set decode without error
+// and report false for every predicate other than their own comparison.
+type ClusterState string
+
+const (
+	ClusterStateCreating   ClusterState = "creating"
+	ClusterStateDestroying ClusterState = "destroying"
+	ClusterStateFailed     ClusterState = "failed"
+	ClusterStateReady      ClusterState = "ready"
+	ClusterStateRestarting ClusterState = "restarting"
+	ClusterStateResuming   ClusterState = "resuming"
+	ClusterStateSuspended  ClusterState = "suspended"
+	ClusterStateSuspending ClusterState = "suspending"
+	ClusterStateUnknown    ClusterState = "unknown"
+)
+
+// Known reports whether the state is one this client understands
+func (s ClusterState) Known() bool {
+	switch s {
+	case ClusterStateCreating, ClusterStateDestroying, ClusterStateFailed,
+		ClusterStateReady, ClusterStateRestarting, ClusterStateResuming,
+		ClusterStateSuspended, ClusterStateSuspending, ClusterStateUnknown:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether the cluster has settled, meaning it will not
+// leave the state without further action being taken on it
+func (s ClusterState) IsTerminal() bool {
+	switch s {
+	case ClusterStateFailed, ClusterStateReady, ClusterStateSuspended:
+		return true
+	}
+	return false
+}
+
+// IsTransitional reports whether the cluster is moving between states on its own
+func (s ClusterState) IsTransitional() bool {
+	switch s {
+	case ClusterStateCreating, ClusterStateDestroying, ClusterStateRestarting,
+		ClusterStateResuming, ClusterStateSuspending:
+		return true
+	}
+	return false
+}
+
+// IsFailed reports whether the cluster is in a failed state
+func (s ClusterState) IsFailed() bool {
+	return s == ClusterStateFailed
+}
+
+// UpgradeFlavor is the kind of an ongoing cluster upgrade operation. As with
+// ClusterState, unrecognized values are tolerated.
+type UpgradeFlavor string
+
+const (
+	UpgradeFlavorHAChange            UpgradeFlavor = "ha_change"
+	UpgradeFlavorMaintenance         UpgradeFlavor = "maintenance"
+	UpgradeFlavorMajorVersionUpgrade UpgradeFlavor = "major_version_upgrade"
+	UpgradeFlavorPlanChange          UpgradeFlavor = "plan_change"
+	UpgradeFlavorResize              UpgradeFlavor = "resize"
+)
+
+// Known reports whether the flavor is one this client understands
+func (f UpgradeFlavor) Known() bool {
+	switch f {
+	case UpgradeFlavorHAChange, UpgradeFlavorMaintenance, UpgradeFlavorMajorVersionUpgrade,
+		UpgradeFlavorPlanChange, UpgradeFlavorResize:
+		return true
+	}
+	return false
+}
+
+// UpgradeState is the progress of an ongoing cluster upgrade operation.
+// Operations are removed from the status once complete, so there is no
+// completed state to check for.
+type UpgradeState string
+
+const (
+	UpgradeStateCreatingNewInstance UpgradeState = "creating_new_instance"
+	UpgradeStateDisablingHA         UpgradeState = "disabling_ha"
+	UpgradeStateEnablingHA          UpgradeState = "enabling_ha"
+	UpgradeStateFailingOver         UpgradeState = "failing_over"
+	UpgradeStateInProgress          UpgradeState = "in_progress"
+	UpgradeStateReplayingWAL        UpgradeState = "replaying_wal"
+	UpgradeStateScheduled           UpgradeState = "scheduled"
+	UpgradeStateWaitingForHAStandby UpgradeState = "waiting_for_ha_standby"
+)
+
+// Known reports whether the state is one this client understands
+func (s UpgradeState) Known() bool {
+	switch s {
+	case UpgradeStateCreatingNewInstance, UpgradeStateDisablingHA, UpgradeStateEnablingHA,
+		UpgradeStateFailingOver, UpgradeStateInProgress, UpgradeStateReplayingWAL,
+		UpgradeStateScheduled, UpgradeStateWaitingForHAStandby:
+		return true
+	}
+	return false
+}
+
+// IsTransitional reports whether the operation is actively being carried out,
+// as opposed to waiting for its scheduled start
+func (s UpgradeState) IsTransitional() bool {
+	return s != UpgradeStateScheduled
+}
+
+// PeeringStatus is the lifecycle state of a network peering. As with
+// ClusterState, unrecognized values are tolerated.
+type PeeringStatus string
+
+const (
+	PeeringStatusActive            PeeringStatus = "active"
+	PeeringStatusDeleting          PeeringStatus = "deleting"
+	PeeringStatusExpired           PeeringStatus = "expired"
+	PeeringStatusFailed            PeeringStatus = "failed"
+	PeeringStatusPending           PeeringStatus = "pending"
+	PeeringStatusPendingAcceptance PeeringStatus = "pending_acceptance"
+	PeeringStatusRejected          PeeringStatus = "rejected"
+)
+
+// Known reports whether the status is one this client understands
+func (s PeeringStatus) Known() bool {
+	switch s {
+	case PeeringStatusActive, PeeringStatusDeleting, PeeringStatusExpired,
+		PeeringStatusFailed, PeeringStatusPending, PeeringStatusPendingAcceptance,
+		PeeringStatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsFailed reports whether the peering can no longer become active, either
+// because provisioning failed or because the peer side never accepted it
+func (s PeeringStatus) IsFailed() bool {
+	switch s {
+	case PeeringStatusExpired, PeeringStatusFailed, PeeringStatusRejected:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package bridgeapi
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestClusterStatePredicates(t *testing.T) {
+	cases := []struct {
+		state        ClusterState
+		known        bool
+		terminal     bool
+		transitional bool
+		failed       bool
+	}{
+		{ClusterStateCreating, true, false, true, false},
+		{ClusterStateDestroying, true, false, true, false},
+		{ClusterStateFailed, true, true, false, true},
+		{ClusterStateReady, true, true, false, false},
+		{ClusterStateSuspended, true, true, false, false},
+		{ClusterStateUnknown, true, false, false, false},
+		{ClusterState("hibernating"), false, false, false, false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.state.Known(); got != tc.known {
+			t.Errorf("%s: Known() = %t, want %t", tc.state, got, tc.known)
+		}
+		if got := tc.state.IsTerminal(); got != tc.terminal {
+			t.Errorf("%s: IsTerminal() = %t, want %t", tc.state, got, tc.terminal)
+		}
+		if got := tc.state.IsTransitional(); got != tc.transitional {
+			t.Errorf("%s: IsTransitional() = %t, want %t", tc.state, got, tc.transitional)
+		}
+		if got := tc.state.IsFailed(); got != tc.failed {
+			t.Errorf("%s: IsFailed() = %t, want %t", tc.state, got, tc.failed)
+		}
+	}
+}
+
+func TestClusterStatusUnknownValues(t *testing.T) {
+	body := `{"state": "hibernating", "ongoing_upgrade": {"operations": [{"flavor": "teleport", "state": "scheduled"}]}}`
+
+	var status ClusterStatus
+	if err := json.Unmarshal([]byte(body), &status); err != nil {
+		t.Fatalf("unexpected error decoding unknown values: %s", err)
+	}
+	if status.State.Known() {
+		t.Errorf("state %s should not be known", status.State)
+	}
+
+	op := status.OngoingUpgrade.Operations[0]
+	if op.Flavor.Known() {
+		t.Errorf("flavor %s should not be known", op.Flavor)
+	}
+	if op.State.IsTransitional() {
+		t.Errorf("scheduled operation should not be transitional")
+	}
+}
+
+func TestPeeringStatusPredicates(t *testing.T) {
+	cases := []struct {
+		status PeeringStatus
+		known  bool
+		failed bool
+	}{
+		{PeeringStatusActive, true, false},
+		{PeeringStatusPendingAcceptance, true, false},
+		{PeeringStatusExpired, true, true},
+		{PeeringStatusRejected, true, true},
+		{PeeringStatus("paused"), false, false},
+	}
+
+	for _, tc := range cases {
+		if got := tc.status.Known(); got != tc.known {
+			t.Errorf("%s: Known() = %t, want %t", tc.status, got, tc.known)
+		}
+		if got := tc.status.IsFailed(); got != tc.failed {
+			t.Errorf("%s: IsFailed() = %t, want %t", tc.status, got, tc.failed)
+		}
+	}
+}
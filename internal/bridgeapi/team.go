@@ -0,0 +1,382 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// CreateTeam creates a team with the authenticated account as its admin
+func (c *Client) CreateTeam(tr TeamCreateRequest) (Team, error) {
+	if err := c.login(); err != nil {
+		return Team{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, routeTeams)
+
+	reqPayload, err := json.Marshal(tr)
+	if err != nil {
+		return Team{}, fmt.Errorf("error during team request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return Team{}, fmt.Errorf("during team create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Team{}, fmt.Errorf("during team create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusBadRequest {
+			return Team{}, fmt.Errorf("team create bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		}
+		return Team{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	var team Team
+	err = json.NewDecoder(resp.Body).Decode(&team)
+	if err != nil {
+		return Team{}, fmt.Errorf("error unmarshaling response body (team create): %w", err)
+	}
+
+	return team, nil
+}
+
+// Team fetches a single team, wrapping ErrorNotFound when it doesn't exist or
+// the account isn't a member of it
+func (c *Client) Team(id string) (Team, error) {
+	if err := c.login(); err != nil {
+		return Team{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeTeams, id)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return Team{}, fmt.Errorf("during team detail request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return Team{}, fmt.Errorf("during team detail request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Team{}, fmt.Errorf("team [%s] %w", id, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return Team{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var team Team
+	err = json.NewDecoder(resp.Body).Decode(&team)
+	if err != nil {
+		return Team{}, fmt.Errorf("error unmarshaling response body (team detail): %w", err)
+	}
+
+	return team, nil
+}
+
+func (c *Client) UpdateTeam(id string, tr TeamUpdateRequest) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeTeams, id)
+
+	reqPayload, err := json.Marshal(tr)
+	if err != nil {
+		return fmt.Errorf("error during team update encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return fmt.Errorf("during team update request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during team update request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteTeam(id string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, routeTeams, id)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during team delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during team delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		if resp.StatusCode == http.StatusConflict {
+			return fmt.Errorf("team delete conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+		}
+		return fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+	}
+
+	return nil
+}
+
+// TeamMembers lists the members of the team along with their roles
+func (c *Client) TeamMembers(teamID string) ([]TeamMember, error) {
+	if err := c.login(); err != nil {
+		return []TeamMember{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeTeamMembers, teamID))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return []TeamMember{}, fmt.Errorf("during team member list request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return []TeamMember{}, fmt.Errorf("during team member list request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return []TeamMember{}, fmt.Errorf("team [%s] %w", teamID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return []TeamMember{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	response := map[string][]TeamMember{
+		"members": {},
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	if err != nil {
+		return []TeamMember{}, fmt.Errorf("error unmarshaling response body (team member list): %w", err)
+	}
+
+	list := response["members"]
+	return list, nil
+}
+
+// TeamMember fetches a single membership, wrapping ErrorNotFound when the team
+// or membership doesn't exist
+func (c *Client) TeamMember(teamID, memberID string) (TeamMember, error) {
+	if err := c.login(); err != nil {
+		return TeamMember{}, err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeTeamMembers, teamID), memberID)
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("during team member detail request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("during team member detail request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return TeamMember{}, fmt.Errorf("team member [%s] %w", memberID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return TeamMember{}, fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	var member TeamMember
+	err = json.NewDecoder(resp.Body).Decode(&member)
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("error unmarshaling response body (team member detail): %w", err)
+	}
+
+	return member, nil
+}
+
+// AddTeamMember adds the account with the given email to the team, inviting
+// it when no account exists yet
+func (c *Client) AddTeamMember(teamID string, mr TeamMemberCreateRequest) (TeamMember, error) {
+	if err := c.login(); err != nil {
+		return TeamMember{}, err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeTeamMembers, teamID))
+
+	reqPayload, err := json.Marshal(mr)
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("error during team member request encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("during team member create request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("during team member create request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var mesg APIMessage
+		if err = json.NewDecoder(resp.Body).Decode(&mesg); err != nil {
+			mesg.Message = "unable to retrieve further error details"
+		}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			return TeamMember{}, fmt.Errorf("team member bad request message %w: %s, request_id: %s", ErrorBadRequest, mesg.Message, mesg.RequestID)
+		case http.StatusConflict:
+			return TeamMember{}, fmt.Errorf("team member conflict message %w: %s, request_id: %s", ErrorConflict, mesg.Message, mesg.RequestID)
+		default:
+			return TeamMember{}, fmt.Errorf("unexpected response status from API, status: %d, message: %s", resp.StatusCode, mesg.Message)
+		}
+	}
+
+	var member TeamMember
+	err = json.NewDecoder(resp.Body).Decode(&member)
+	if err != nil {
+		return TeamMember{}, fmt.Errorf("error unmarshaling response body (team member create): %w", err)
+	}
+
+	return member, nil
+}
+
+func (c *Client) UpdateTeamMember(teamID, memberID string, mr TeamMemberUpdateRequest) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeTeamMembers, teamID), memberID)
+
+	reqPayload, err := json.Marshal(mr)
+	if err != nil {
+		return fmt.Errorf("error during team member update encoding: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, route, bytes.NewReader(reqPayload))
+	if err != nil {
+		return fmt.Errorf("during team member update request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during team member update request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveTeamMember(teamID, memberID string) error {
+	if err := c.login(); err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("%s%s/%s", c.apiTarget, fmt.Sprintf(routeTeamMembers, teamID), memberID)
+
+	req, err := http.NewRequest(http.MethodDelete, route, nil)
+	if err != nil {
+		return fmt.Errorf("during team member delete request: %w", err)
+	}
+	c.setCommonHeaders(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("during team member delete request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// TeamCertificate fetches the PEM encoded CA certificate bundle that signs the
+// server certificates of every cluster in the team
+func (c *Client) TeamCertificate(teamID string) (string, error) {
+	if err := c.login(); err != nil {
+		return "", err
+	}
+
+	route := fmt.Sprint(c.apiTarget, fmt.Sprintf(routeTeamCert, teamID))
+
+	req, err := http.NewRequest(http.MethodGet, route, nil)
+	if err != nil {
+		return "", fmt.Errorf("during team certificate request: %w", err)
+	}
+	c.setCommonHeaders(req)
+	req.Header.Set("Accept", "application/x-pem-file")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("during team certificate request prep: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("team [%s] %w", teamID, ErrorNotFound)
+	} else if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response status from API, status: %d", resp.StatusCode)
+	}
+
+	cert, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading response body (team certificate): %w", err)
+	}
+
+	return string(cert), nil
+}
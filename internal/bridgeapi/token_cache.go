@@ -0,0 +1,164 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package bridgeapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Cached tokens closer than this to expiring are discarded rather than reused,
+// so that a token can't expire partway through a terraform run
+const tokenCacheMinLife = 10 * time.Minute
+
+type tokenCacheEntry struct {
+	Expires time.Time `json:"expires_at"`
+	Token   string    `json:"access_token"`
+	TokenID string    `json:"id"`
+}
+
+// tokenCacheKey identifies the cache entry for the client's credential and API
+// target. The credential is hashed so that the cache file never contains it.
+func (c *Client) tokenCacheKey() string {
+	sum := sha256.Sum256([]byte(c.credential.Key + "\x00" + c.credential.Secret))
+	return hex.EncodeToString(sum[:]) + "@" + c.apiTarget.String()
+}
+
+// lockTokenCache takes an exclusive advisory lock on a file beside the cache,
+// returning the function that releases it. Clients hold the lock across reading
+// and rewriting the cache so that concurrent processes don't lose entries.
+func lockTokenCache(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("error creating token cache directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("error opening token cache lock: %w", err)
+	}
+	if err = lockFile(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("error locking token cache: %w", err)
+	}
+
+	return func() {
+		_ = unlockFile(f)
+		f.Close()
+	}, nil
+}
+
+func readTokenCache(path string) (map[string]tokenCacheEntry, error) {
+	entries := map[string]tokenCacheEntry{}
+
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return entries, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error checking token cache: %w", err)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("token cache %s must not be accessible to other users, expected mode 0600", path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading token cache: %w", err)
+	}
+	if err = json.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("error unmarshaling token cache: %w", err)
+	}
+
+	return entries, nil
+}
+
+// writeTokenCache replaces the cache file in a single rename so concurrent
+// readers never observe a partially written file
+func writeTokenCache(path string, entries map[string]tokenCacheEntry) error {
+	content, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("error encoding token cache: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("error creating token cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("error creating token cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	// CreateTemp already uses 0600, but be explicit as the file holds secrets
+	if err = tmp.Chmod(0600); err == nil {
+		_, err = tmp.Write(content)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing token cache: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// cachedToken returns a reusable token from the cache, if any. An entry that is
+// too close to expiring isn't reused, but it's left to be replaced by the next
+// exchange rather than logged out since other processes may still be using it.
+// Callers must hold the lock from lockTokenCache.
+func (c *Client) cachedToken() (tokenCacheEntry, bool, error) {
+	entries, err := readTokenCache(c.tokenCachePath)
+	if err != nil {
+		return tokenCacheEntry{}, false, err
+	}
+
+	entry, ok := entries[c.tokenCacheKey()]
+	if !ok || time.Until(entry.Expires) <= tokenCacheMinLife {
+		return tokenCacheEntry{}, false, nil
+	}
+
+	return entry, true, nil
+}
+
+// cacheToken stores the entry for the client's credential. The token of any
+// entry it replaces is left to expire on its own, since processes that read it
+// from the cache may still be using it. Callers must hold the lock from
+// lockTokenCache.
+func (c *Client) cacheToken(entry tokenCacheEntry) error {
+	entries, err := readTokenCache(c.tokenCachePath)
+	if err != nil {
+		return err
+	}
+
+	// Drop expired entries for other credentials while the file is being rewritten
+	for key, other := range entries {
+		if time.Until(other.Expires) <= 0 {
+			delete(entries, key)
+		}
+	}
+	entries[c.tokenCacheKey()] = entry
+
+	return writeTokenCache(c.tokenCachePath, entries)
+}
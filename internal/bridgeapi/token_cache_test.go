@@ -0,0 +1,203 @@
+package bridgeapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTokenCacheClient returns a client caching tokens in a fresh directory,
+// along with the IDs of the tokens its fake API has been asked to log out
+func newTokenCacheClient(t *testing.T) (*Client, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	deleted := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		deleted = append(deleted, filepath.Base(r.URL.Path))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	target, _ := url.Parse(server.URL)
+	client, err := NewClient(target, Login{Key: "key", Secret: "secret"},
+		WithTokenExchange(), WithTokenCache(filepath.Join(t.TempDir(), "tokens.json")))
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	return client, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, deleted...)
+	}
+}
+
+func TestReadTokenCacheRejectsOpenMode(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	if err := os.WriteFile(path, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// WriteFile is subject to the umask, so set the mode explicitly
+	if err := os.Chmod(path, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readTokenCache(path); err == nil {
+		t.Fatal("expected an error for a cache readable by other users")
+	}
+
+	if err := os.Chmod(path, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readTokenCache(path); err != nil {
+		t.Fatalf("unexpected error for a private cache: %s", err)
+	}
+}
+
+func TestCachedTokenDiscardsNearExpiry(t *testing.T) {
+	client, deleted := newTokenCacheClient(t)
+
+	err := writeTokenCache(client.tokenCachePath, map[string]tokenCacheEntry{
+		client.tokenCacheKey(): {Expires: time.Now().Add(tokenCacheMinLife / 2), Token: "old", TokenID: "old-id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok, err := client.cachedToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ok {
+		t.Error("expected a token near expiry not to be reused")
+	}
+	if got := deleted(); len(got) != 0 {
+		t.Errorf("expected a possibly shared token not to be logged out, got %v", got)
+	}
+}
+
+func TestCachedTokenReusesCurrent(t *testing.T) {
+	client, deleted := newTokenCacheClient(t)
+
+	err := writeTokenCache(client.tokenCachePath, map[string]tokenCacheEntry{
+		client.tokenCacheKey(): {Expires: time.Now().Add(time.Hour), Token: "current", TokenID: "current-id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry, ok, err := client.cachedToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !ok || entry.Token != "current" {
+		t.Errorf("expected the current token to be reused, got %v, %t", entry, ok)
+	}
+	if got := deleted(); len(got) != 0 {
+		t.Errorf("expected no logouts, got %v", got)
+	}
+}
+
+func TestCacheTokenDropsExpiredEntries(t *testing.T) {
+	client, deleted := newTokenCacheClient(t)
+
+	err := writeTokenCache(client.tokenCachePath, map[string]tokenCacheEntry{
+		"expired": {Expires: time.Now().Add(-time.Minute), Token: "expired", TokenID: "expired-id"},
+		"other":   {Expires: time.Now().Add(time.Hour), Token: "other", TokenID: "other-id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.cacheToken(tokenCacheEntry{Expires: time.Now().Add(time.Hour), Token: "new", TokenID: "new-id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := readTokenCache(client.tokenCachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := entries["expired"]; ok {
+		t.Error("expected the expired entry to be dropped")
+	}
+	if _, ok := entries["other"]; !ok {
+		t.Error("expected the other credential's entry to be kept")
+	}
+	if entries[client.tokenCacheKey()].Token != "new" {
+		t.Errorf("expected the new token to be cached, got %v", entries)
+	}
+	if got := deleted(); len(got) != 0 {
+		t.Errorf("expected no logouts, got %v", got)
+	}
+}
+
+func TestCacheTokenKeepsReplacedTokenActive(t *testing.T) {
+	client, deleted := newTokenCacheClient(t)
+
+	err := writeTokenCache(client.tokenCachePath, map[string]tokenCacheEntry{
+		client.tokenCacheKey(): {Expires: time.Now().Add(time.Hour), Token: "old", TokenID: "old-id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.cacheToken(tokenCacheEntry{Expires: time.Now().Add(time.Hour), Token: "new", TokenID: "new-id"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries, err := readTokenCache(client.tokenCachePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries[client.tokenCacheKey()].Token != "new" {
+		t.Errorf("expected the new token to replace the old one, got %v", entries)
+	}
+	if got := deleted(); len(got) != 0 {
+		t.Errorf("expected a possibly shared token not to be logged out, got %v", got)
+	}
+}
+
+func TestLockTokenCacheExcludes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+
+	unlock, err := lockTokenCache(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		unlockOther, err := lockTokenCache(path)
+		if err == nil {
+			unlockOther()
+		}
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("lock acquired while already held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("lock not acquired after release")
+	}
+}
@@ -92,10 +92,11 @@ func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	// Null default_team_id means personal team is default which matches the user id.
 	// Make the substitution here so DefaultTeamID is presented to the user as expected
-	if acct.DefaultTeamID == "" {
-		acct.DefaultTeamID = acct.ID
+	defaultTeam := acct.ID
+	if acct.DefaultTeamID != nil {
+		defaultTeam = *acct.DefaultTeamID
 	}
-	err = d.Set("default_team", acct.DefaultTeamID)
+	err = d.Set("default_team", defaultTeam)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
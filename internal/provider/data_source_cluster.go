@@ -77,6 +77,11 @@ func dataSourceCluster() *schema.Resource {
 				Description: "A human-readable name for the cluster.",
 				Type:        schema.TypeString,
 			},
+			"network_id": {
+				Computed:    true,
+				Description: "The ID of the network in [EID format](https://docs.crunchybridge.com/api-concepts/eid) the cluster is placed in.",
+				Type:        schema.TypeString,
+			},
 			"plan_id": {
 				Computed:    true,
 				Description: "The ID of the [cluster's plan](https://docs.crunchybridge.com/concepts/plans-pricing/). Determines instance, CPU, and memory.",
@@ -92,11 +97,27 @@ func dataSourceCluster() *schema.Resource {
 				Description: "The [provider region](https://docs.crunchybridge.com/api/provider#region) where the cluster is located.",
 				Type:        schema.TypeString,
 			},
+			"replica_ids": {
+				Computed:    true,
+				Description: "The IDs of the cluster's read replicas.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"storage": {
 				Computed:    true,
 				Description: "The amount of storage available to the cluster in GB (gigabytes).",
 				Type:        schema.TypeInt,
 			},
+			"tags": {
+				Computed:    true,
+				Description: "Every tag on the cluster.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"team_id": {
 				Computed:    true,
 				Description: "The ID of the parent [team](https://docs.crunchybridge.com/concepts/teams/) for the cluster.",
@@ -150,9 +171,13 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
-	err = d.Set("maintenance_window_start", cd.MaintWindowStart)
-	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	// Don't set maintenance_window_start without a window, since setting nil
+	// would store 0 and claim a midnight UTC window
+	if cd.MaintWindowStart != nil {
+		err = d.Set("maintenance_window_start", *cd.MaintWindowStart)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
 	}
 	err = d.Set("memory", cd.MemoryGB)
 	if err != nil {
@@ -162,6 +187,10 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("network_id", cd.NetworkID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("plan_id", cd.PlanID)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -174,10 +203,18 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("replica_ids", replicaIDs(cd))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("storage", cd.StorageGB)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("tags", cd.Tags)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("team_id", cd.TeamID)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceClusterBackups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Source for retreiving a cluster's base backups and the window of time it can be restored to",
+		ReadContext: dataSourceClusterBackupsRead,
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			// "Result / Computed Fields"
+			"backups": {
+				Computed:    true,
+				Description: "The cluster's base backups, oldest first.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Computed:    true,
+							Description: "The name of the backup.",
+							Type:        schema.TypeString,
+						},
+						"started_at": {
+							Computed:    true,
+							Description: "Time the backup started formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
+							Type:        schema.TypeString,
+						},
+						"finished_at": {
+							Computed:    true,
+							Description: "Time the backup finished formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339). Null while the backup is in progress.",
+							Type:        schema.TypeString,
+						},
+						"size_bytes": {
+							Computed:    true,
+							Description: "The size of the backup in bytes.",
+							Type:        schema.TypeInt,
+						},
+						"lsn_start": {
+							Computed:    true,
+							Description: "The WAL position at which the backup started.",
+							Type:        schema.TypeString,
+						},
+						"lsn_stop": {
+							Computed:    true,
+							Description: "The WAL position at which the backup finished.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			"recovery_window_start": {
+				Computed:    true,
+				Description: "The earliest time the cluster can be restored to, which is when its oldest completed backup finished, formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339). Null until a backup completes.",
+				Type:        schema.TypeString,
+			},
+			"recovery_window_end": {
+				Computed:    true,
+				Description: "The latest time the cluster is known to be restorable to, which is when its newest completed backup finished, formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339). WAL archived since then may allow restoring to later times. Null until a backup completes.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceClusterBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	clusterID := d.Get("cluster_id").(string)
+	d.SetId(clusterID)
+
+	backups, err := client.ClusterBackups(clusterID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Started.Before(backups[j].Started)
+	})
+
+	diags := []diag.Diagnostic{}
+
+	// Restores replay WAL on top of a completed backup, so the window opens when
+	// the first backup finished and is known to reach at least the last one
+	var oldestFinished, newestFinished *time.Time
+	backupList := []interface{}{}
+	for _, backup := range backups {
+		item := map[string]interface{}{
+			"name":       backup.Name,
+			"started_at": backup.Started.Format(time.RFC3339),
+			"size_bytes": int(backup.SizeBytes),
+			"lsn_start":  backup.LSNStart,
+			"lsn_stop":   backup.LSNStop,
+		}
+		if backup.Finished != nil {
+			item["finished_at"] = backup.Finished.Format(time.RFC3339)
+
+			if oldestFinished == nil || backup.Finished.Before(*oldestFinished) {
+				oldestFinished = backup.Finished
+			}
+			if newestFinished == nil || backup.Finished.After(*newestFinished) {
+				newestFinished = backup.Finished
+			}
+		}
+		backupList = append(backupList, item)
+	}
+
+	err = d.Set("backups", backupList)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Don't set the window until a backup completes, leaving it null
+	if oldestFinished != nil {
+		err = d.Set("recovery_window_start", oldestFinished.Format(time.RFC3339))
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("recovery_window_end", newestFinished.Format(time.RFC3339))
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diag.Diagnostics(diags)
+}
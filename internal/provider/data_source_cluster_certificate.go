@@ -0,0 +1,133 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceClusterCertificate() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Source for retrieving the CA certificate that signs a cluster's server certificate, " +
+			"for clients connecting with `sslmode=verify-full`. Every cluster in a team shares the team's CA.",
+		ReadContext: dataSourceClusterCertificateRead,
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid). Exactly one of `cluster_id` or `team_id` is required.",
+				ExactlyOneOf: []string{"cluster_id", "team_id"},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"team_id": {
+				Computed:     true,
+				Description:  "The ID of the [team](https://docs.crunchybridge.com/concepts/teams/) whose CA to retrieve. Set from the cluster when `cluster_id` is given.",
+				ExactlyOneOf: []string{"cluster_id", "team_id"},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			// "Result / Computed Fields"
+			"certificate": {
+				Computed:    true,
+				Description: "The PEM encoded CA certificate bundle.",
+				Type:        schema.TypeString,
+			},
+			"fingerprint": {
+				Computed:    true,
+				Description: "The hex encoded SHA-256 fingerprint of the first certificate in the bundle.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceClusterCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	teamID := d.Get("team_id").(string)
+	if clusterID := d.Get("cluster_id").(string); clusterID != "" {
+		cd, err := client.ClusterDetail(clusterID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		teamID = cd.TeamID
+	}
+
+	cert, err := client.TeamCertificate(teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fingerprint, err := certificateFingerprint(cert)
+	if err != nil {
+		return diag.Errorf("error reading CA certificate of team %s: %s", teamID, err)
+	}
+
+	d.SetId(teamID)
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("team_id", teamID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("certificate", cert)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("fingerprint", fingerprint)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint of the first certificate
+// in a PEM bundle, checking that it parses as a certificate along the way
+func certificateFingerprint(bundle string) (string, error) {
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return "", fmt.Errorf("no certificate found in PEM data")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return "", fmt.Errorf("error parsing certificate: %w", err)
+		}
+
+		sum := sha256.Sum256(block.Bytes)
+		return hex.EncodeToString(sum[:]), nil
+	}
+}
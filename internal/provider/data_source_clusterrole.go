@@ -17,6 +17,8 @@ package provider
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
 
@@ -35,7 +37,20 @@ func dataSourceRoles() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"name": {
+				Description: "Name of a single role to retrieve into `role`, for example a user role such as `u_abc123`.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 			// "Result / Computed Fields"
+			"role": {
+				Computed:    true,
+				Description: "The role identified by `name`, when it is set.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"superuser": {
 				Computed:    true,
 				Description: "Superuser role provided for the cluster.",
@@ -54,13 +69,18 @@ func dataSourceRoles() *schema.Resource {
 			},
 			"user_roles": {
 				Computed:    true,
-				Description: "User roles provided for the cluster. Not currently used except to document the other role formats.",
+				Description: "Every user role (`u_` prefixed) on the cluster, ordered by name.",
 				Type:        schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Computed:    true,
+							Description: "The ID of the account the role belongs to in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+							Type:        schema.TypeString,
+						},
 						"name": {
 							Computed:    true,
-							Description: "Name of the role in Postgres. Either `application` or `postgres`, enumeration of `u_` roles is not available yet.",
+							Description: "Name of the role in Postgres.",
 							Type:        schema.TypeString,
 						},
 						"password": {
@@ -97,32 +117,48 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	diags := []diag.Diagnostic{}
-	userRoles := []map[string]string{}
+	userRoles := []interface{}{}
 
-	for _, roleItem := range roleList {
-		roleMap := map[string]string{
-			"name":       roleItem.Name,
-			"password":   roleItem.Password,
-			"cluster_id": roleItem.ClusterID,
-			"uri":        roleItem.URI,
-		}
+	sort.Slice(roleList, func(i, j int) bool {
+		return roleList[i].Name < roleList[j].Name
+	})
 
+	for _, roleItem := range roleList {
 		if roleItem.Name == "postgres" {
-			err = d.Set("superuser", roleMap)
+			err = d.Set("superuser", roleMap(roleItem))
 			if err != nil {
 				diags = append(diags, diag.FromErr(err)...)
 			}
 		} else if roleItem.Name == "application" {
-			err = d.Set("application", roleMap)
+			err = d.Set("application", roleMap(roleItem))
 			if err != nil {
 				diags = append(diags, diag.FromErr(err)...)
 			}
-		} else {
-			userRoles = append(userRoles, roleMap)
+		} else if strings.HasPrefix(roleItem.Name, userRolePrefix) {
+			var accountID string
+			if roleItem.AccountID != nil {
+				accountID = *roleItem.AccountID
+			}
+			userRoles = append(userRoles, map[string]interface{}{
+				"account_id": accountID,
+				"name":       roleItem.Name,
+				"password":   roleItem.Password,
+				"team_id":    roleItem.TeamID,
+				"uri":        roleItem.URI,
+			})
 		}
 	}
-	if len(userRoles) > 0 {
-		err = d.Set("user_roles", userRoles)
+	err = d.Set("user_roles", userRoles)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if name := d.Get("name").(string); name != "" {
+		role, err := client.ClusterRole(id, name)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		err = d.Set("role", roleMap(role))
 		if err != nil {
 			diags = append(diags, diag.FromErr(err)...)
 		}
@@ -130,3 +166,12 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return diag.Diagnostics(diags)
 }
+
+func roleMap(role bridgeapi.ClusterRole) map[string]string {
+	return map[string]string{
+		"name":       role.Name,
+		"password":   role.Password,
+		"cluster_id": role.ClusterID,
+		"uri":        role.URI,
+	}
+}
@@ -44,7 +44,7 @@ func dataSourceStatus() *schema.Resource {
 			},
 			"state": {
 				Computed:    true,
-				Description: "The state of the cluster. `creating`, `destroying`, `ready`, or `restarting`",
+				Description: "The state of the cluster. For example `creating`, `destroying`, `failed`, `ready`, `restarting`, `resuming`, `suspended`, or `suspending`.",
 				Type:        schema.TypeString,
 			},
 			"disk_available_mb": {
@@ -98,7 +98,7 @@ func dataSourceStatusRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	diags := []diag.Diagnostic{}
 
-	err = d.Set("state", cs.State)
+	err = d.Set("state", string(cs.State))
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -115,8 +115,8 @@ func dataSourceStatusRead(ctx context.Context, d *schema.ResourceData, meta inte
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	// Don't set oldest_backup if time zero value (i.e. was originally nulled at API level)
-	if !cs.OldestBackup.IsZero() {
+	// Don't set oldest_backup if the API reports no backup yet, leaving it null
+	if cs.OldestBackup != nil {
 		err = d.Set("oldest_backup", cs.OldestBackup.Format(time.RFC3339))
 		if err != nil {
 			diags = append(diags, diag.FromErr(err)...)
@@ -127,8 +127,8 @@ func dataSourceStatusRead(ctx context.Context, d *schema.ResourceData, meta inte
 		updates := []interface{}{}
 		for _, step := range cs.OngoingUpgrade.Operations {
 			us := map[string]interface{}{
-				"flavor": step.Flavor,
-				"state":  step.State,
+				"flavor": string(step.Flavor),
+				"state":  string(step.State),
 			}
 			updates = append(updates, us)
 		}
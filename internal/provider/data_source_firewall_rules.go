@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Source for retreiving the firewall rules of a network",
+		ReadContext: dataSourceFirewallRulesRead,
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"network_id": {
+				Computed:     true,
+				Description:  "The ID of the network in [EID format](https://docs.crunchybridge.com/api-concepts/eid). Exactly one of `network_id` or `cluster_id` is required.",
+				ExactlyOneOf: []string{"network_id", "cluster_id"},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"cluster_id": {
+				Description:  "The ID of a cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) whose network's rules are retrieved.",
+				ExactlyOneOf: []string{"network_id", "cluster_id"},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			// "Result / Computed Fields"
+			"rules": {
+				Computed:    true,
+				Description: "The firewall rules of the network.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Computed:    true,
+							Description: "The ID of the rule in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+							Type:        schema.TypeString,
+						},
+						"cidr": {
+							Computed:    true,
+							Description: "The CIDR block allowed to connect.",
+							Type:        schema.TypeString,
+						},
+						"description": {
+							Computed:    true,
+							Description: "A human-readable description of the rule.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFirewallRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	networkID, err := networkIDFromConfig(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(networkID)
+
+	rules, err := client.FirewallRules(networkID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("network_id", networkID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	ruleList := []interface{}{}
+	for _, rule := range rules {
+		var description string
+		if rule.Description != nil {
+			description = *rule.Description
+		}
+		ruleList = append(ruleList, map[string]interface{}{
+			"rule_id":     rule.ID,
+			"cidr":        rule.Rule,
+			"description": description,
+		})
+	}
+	err = d.Set("rules", ruleList)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Source for retreiving Network resource data by ID, or by name within a team",
+		ReadContext: dataSourceNetworkRead,
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"id": {
+				Computed:     true,
+				Description:  "The unique ID of the network in [EID format](https://docs.crunchybridge.com/api-concepts/eid). Exactly one of `id` or `name` is required.",
+				ExactlyOneOf: []string{"id", "name"},
+				Optional:     true,
+				Type:         schema.TypeString,
+			},
+			"name": {
+				Computed:     true,
+				Description:  "The name of the network to look up, requires `team_id`.",
+				ExactlyOneOf: []string{"id", "name"},
+				Optional:     true,
+				RequiredWith: []string{"team_id"},
+				Type:         schema.TypeString,
+			},
+			"team_id": {
+				Computed:    true,
+				Description: "The ID of the parent [team](https://docs.crunchybridge.com/concepts/teams/) for the network.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			// "Result / Computed Fields"
+			"cidr": {
+				Computed:    true,
+				Description: "The IPv4 CIDR block of the network.",
+				Type:        schema.TypeString,
+			},
+			"provider_id": {
+				Computed:    true,
+				Description: "The [cloud provider](https://docs.crunchybridge.com/api/provider) where the network is located.",
+				Type:        schema.TypeString,
+			},
+			"region_id": {
+				Computed:    true,
+				Description: "The [provider region](https://docs.crunchybridge.com/api/provider#region) where the network is located.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	var network bridgeapi.Network
+	if id := d.Get("id").(string); id != "" {
+		var err error
+		network, err = client.Network(id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		name, teamID := d.Get("name").(string), d.Get("team_id").(string)
+
+		networks, err := client.NetworksForTeam(teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		matches := 0
+		for _, candidate := range networks {
+			if candidate.Name == name {
+				network = candidate
+				matches++
+			}
+		}
+		if matches != 1 {
+			return diag.Errorf("expected exactly one network named %q in team %s, found %d", name, teamID, matches)
+		}
+	}
+
+	d.SetId(network.ID)
+
+	return setNetworkData(d, network)
+}
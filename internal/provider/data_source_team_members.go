@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data Source for retreiving the current members of a team",
+		ReadContext: dataSourceTeamMembersRead,
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"team_id": {
+				Description:  "The ID of the [team](https://docs.crunchybridge.com/concepts/teams/) in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			// "Result / Computed Fields"
+			"members": {
+				Computed:    true,
+				Description: "The members of the team, ordered by email.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member_id": {
+							Computed:    true,
+							Description: "The ID of the membership in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+							Type:        schema.TypeString,
+						},
+						"account_id": {
+							Computed:    true,
+							Description: "The ID of the member's account in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+							Type:        schema.TypeString,
+						},
+						"email": {
+							Computed:    true,
+							Description: "The email address of the member's account.",
+							Type:        schema.TypeString,
+						},
+						"role": {
+							Computed:    true,
+							Description: "The member's role in the team.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTeamMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	teamID := d.Get("team_id").(string)
+	d.SetId(teamID)
+
+	members, err := client.TeamMembers(teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep ordering stable between reads
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Email < members[j].Email
+	})
+
+	memberList := []interface{}{}
+	for _, member := range members {
+		memberList = append(memberList, map[string]interface{}{
+			"member_id":  member.ID,
+			"account_id": member.AccountID,
+			"email":      member.Email,
+			"role":       member.Role,
+		})
+	}
+
+	err = d.Set("members", memberList)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// joinID builds a resource ID for objects only addressable through a parent,
+// e.g. <cluster_id>/<role_name>, so that import has everything it needs
+func joinID(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
+// splitID reverses joinID, with names describing each expected part for
+// error messages
+func splitID(id string, names ...string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	valid := len(parts) == len(names)
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+
+	if !valid {
+		return nil, fmt.Errorf("unexpected format for ID %q, expected <%s>", id, strings.Join(names, ">/<"))
+	}
+
+	return parts, nil
+}
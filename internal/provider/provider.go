@@ -26,10 +26,13 @@ import (
 )
 
 const (
-	idConfigName     = "application_id"
-	secretConfigName = "application_secret"
-	urlConfigName    = "bridgeapi_url"
-	tokenConfigName  = "require_token_swap"
+	idConfigName          = "application_id"
+	secretConfigName      = "application_secret"
+	urlConfigName         = "bridgeapi_url"
+	tokenConfigName       = "require_token_swap"
+	idempotencyConfigName = "idempotent_create"
+	tokenCacheConfigName  = "token_cache_path"
+	defaultTagsConfigName = "default_tags"
 )
 
 func init() {
@@ -52,15 +55,33 @@ func New(version string) func() *schema.Provider {
 	return func() *schema.Provider {
 		p := &schema.Provider{
 			DataSourcesMap: map[string]*schema.Resource{
-				"crunchybridge_account":       dataSourceAccount(),
-				"crunchybridge_cloudprovider": dataSourceCloudProvider(),
-				"crunchybridge_cluster":       dataSourceCluster(),
-				"crunchybridge_clusterids":    dataSourceClusterIDs(),
-				"crunchybridge_clusterroles":  dataSourceRoles(),
-				"crunchybridge_clusterstatus": dataSourceStatus(),
+				"crunchybridge_account":             dataSourceAccount(),
+				"crunchybridge_cloudprovider":       dataSourceCloudProvider(),
+				"crunchybridge_cluster":             dataSourceCluster(),
+				"crunchybridge_cluster_backups":     dataSourceClusterBackups(),
+				"crunchybridge_cluster_certificate": dataSourceClusterCertificate(),
+				"crunchybridge_clusterids":          dataSourceClusterIDs(),
+				"crunchybridge_clusterroles":        dataSourceRoles(),
+				"crunchybridge_clusterstatus":       dataSourceStatus(),
+				"crunchybridge_firewall_rules":      dataSourceFirewallRules(),
+				"crunchybridge_network":             dataSourceNetwork(),
+				"crunchybridge_team_members":        dataSourceTeamMembers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"crunchybridge_cluster": resourceCluster(),
+				"crunchybridge_api_key":               resourceAPIKey(),
+				"crunchybridge_cluster":               resourceCluster(),
+				"crunchybridge_cluster_backup":        resourceClusterBackup(),
+				"crunchybridge_cluster_operation":     resourceClusterOperation(),
+				"crunchybridge_cluster_parameters":    resourceClusterParameters(),
+				"crunchybridge_cluster_replica":       resourceClusterReplica(),
+				"crunchybridge_cluster_role":          resourceClusterRole(),
+				"crunchybridge_cluster_role_rotation": resourceClusterRoleRotation(),
+				"crunchybridge_firewall_rule":         resourceFirewallRule(),
+				"crunchybridge_log_destination":       resourceLogDestination(),
+				"crunchybridge_network":               resourceNetwork(),
+				"crunchybridge_network_peering":       resourceNetworkPeering(),
+				"crunchybridge_team":                  resourceTeam(),
+				"crunchybridge_team_member":           resourceTeamMember(),
 			},
 			Schema: map[string]*schema.Schema{
 				idConfigName: {
@@ -75,11 +96,40 @@ func New(version string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("APPLICATION_SECRET", nil),
 					Required:    true,
 				},
+				defaultTagsConfigName: {
+					Description: "Tags applied to every cluster managed by the provider. Tags set on a cluster take precedence over default tags with the same key.",
+					MaxItems:    1,
+					Optional:    true,
+					Type:        schema.TypeList,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Description: "Map of tag keys to values.",
+								Optional:    true,
+								Type:        schema.TypeMap,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+						},
+					},
+				},
+				idempotencyConfigName: {
+					Type:        schema.TypeBool,
+					Description: "When true, cluster create requests carry an idempotency key unique to the resource so that retried requests cannot create duplicate clusters.",
+					Optional:    true,
+				},
 				tokenConfigName: {
 					Type:        schema.TypeBool,
 					Description: "When true, forces an exchange of the API key for a short-lived bearer token.",
 					Optional:    true,
 				},
+				tokenCacheConfigName: {
+					Type:        schema.TypeString,
+					Description: "Path to a file caching exchanged tokens between runs when `require_token_swap` is true. Cached tokens are reused until close to expiring and are never logged out, since other runs may share them, so they expire on their own. The file is created with mode `0600` and locked through a sibling `.lock` file while in use, so runs can share it. No cache is used when unset.",
+					DefaultFunc: schema.EnvDefaultFunc("BRIDGE_TOKEN_CACHE", nil),
+					Optional:    true,
+				},
 				urlConfigName: {
 					Type:        schema.TypeString,
 					Description: "The API URL for the Crunchy Bridge platform API. Most users should not need to change this value.",
@@ -124,8 +174,28 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		}
 
 		swapReq := d.Get(tokenConfigName).(bool)
+		cachePath := d.Get(tokenCacheConfigName).(string)
 		if swapReq {
-			options = append(options, bridgeapi.WithTokenExchange(), bridgeapi.WithImmediateLogin())
+			options = append(options, bridgeapi.WithTokenExchange())
+			// Cache must be configured ahead of the login
+			if cachePath != "" {
+				options = append(options, bridgeapi.WithTokenCache(cachePath))
+			}
+			options = append(options, bridgeapi.WithImmediateLogin())
+		} else if cachePath != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Token cache not used",
+				Detail:   tokenCacheConfigName + " only applies when " + tokenConfigName + " is true.",
+			})
+		}
+
+		if idemReq := d.Get(idempotencyConfigName).(bool); idemReq {
+			options = append(options, bridgeapi.WithIdempotencyKey())
+		}
+
+		if defaultTags, ok := d.GetOk(defaultTagsConfigName + ".0.tags"); ok {
+			options = append(options, bridgeapi.WithDefaultTags(tagMap(defaultTags)))
 		}
 
 		c, err := bridgeapi.NewClient(apiUrl, login, options...)
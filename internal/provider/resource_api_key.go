@@ -0,0 +1,167 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "API key resource for the Crunchy Bridge Terraform Provider. The key belongs to the authenticated " +
+			"account and is revoked when the resource is destroyed. Rotate a key by replacing the resource.",
+
+		CreateContext: resourceAPIKeyCreate,
+		ReadContext:   resourceAPIKeyRead,
+		DeleteContext: resourceAPIKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"name": {
+				Description:  "A human-readable name for the API key.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"expires_at": {
+				Description: "Time at which the key stops working formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339). The key doesn't expire when unset.",
+				// Compare as times so that equivalent offsets don't replace the key
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldTime, oldErr := time.Parse(time.RFC3339, old)
+					newTime, newErr := time.Parse(time.RFC3339, new)
+					return oldErr == nil && newErr == nil && oldTime.Equal(newTime)
+				},
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The unique ID of the API key in [EID format](https://docs.crunchybridge.com/api-concepts/eid)",
+				Type:        schema.TypeString,
+			},
+			"created_at": {
+				Computed:    true,
+				Description: "Creation time formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
+				Type:        schema.TypeString,
+			},
+			"secret": {
+				Computed:    true,
+				Description: "The `cbkey_` prefixed secret of the API key. The API only returns it when the key is created, so it is unavailable for keys created outside of Terraform.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	req := bridgeapi.APIKeyCreateRequest{
+		Name: d.Get("name").(string),
+	}
+	if expires, ok := d.GetOk("expires_at"); ok {
+		// Already validated as RFC 3339
+		expiresAt, _ := time.Parse(time.RFC3339, expires.(string))
+		req.Expires = &expiresAt
+	}
+
+	tflog.Trace(ctx, "sending API key create request to API")
+
+	key, err := client.CreateAPIKey(req)
+	if err != nil {
+		return diag.Errorf("failed to create API key: %s", err)
+	}
+
+	d.SetId(key.ID)
+
+	// Only chance to capture the secret, Read leaves it untouched
+	err = d.Set("secret", key.Key)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	readDiag := resourceAPIKeyRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	key, err := client.APIKey(d.Id())
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "API key no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("name", key.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("created_at", key.Created.Format(time.RFC3339))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	var expires *string
+	if key.Expires != nil {
+		formatted := key.Expires.Format(time.RFC3339)
+		expires = &formatted
+	}
+	err = d.Set("expires_at", expires)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.RevokeAPIKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -17,16 +17,26 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// clusterPollInterval is the delay between cluster status checks while waiting,
+// set to terraform's notification status interval
+var clusterPollInterval = 10 * time.Second
+
 func staticDefault(value interface{}) func() (interface{}, error) {
 	return func() (interface{}, error) {
 		return value, nil
@@ -42,6 +52,13 @@ func resourceCluster() *schema.Resource {
 		UpdateContext: resourceClusterUpdate,
 		DeleteContext: resourceClusterDelete,
 
+		CustomizeDiff: resourceClusterCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -95,6 +112,13 @@ func resourceCluster() *schema.Resource {
 				Required:    true,
 				Type:        schema.TypeBool,
 			},
+			"network_id": {
+				Computed:     true,
+				Description:  "The ID of the network in [EID format](https://docs.crunchybridge.com/api-concepts/eid) to place the cluster in. The network must be in the cluster's provider and region. A new network is created for the cluster when unset.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
 			"major_version": {
 				Default:      16,
 				Description:  "The cluster's major Postgres version. For example, `16`. Defaults to [Create Cluster](https://docs.crunchybridge.com/api/cluster/#create-cluster) defaults.",
@@ -102,8 +126,53 @@ func resourceCluster() *schema.Resource {
 				Type:         schema.TypeInt,
 				ValidateFunc: validation.IntAtLeast(14),
 			},
+			"maintenance_window_start": {
+				Description: "The hour of day which a maintenance window can possibly start. " +
+					"This should be an integer from `0` to `23` representing the hour of day which " +
+					"maintenance is allowed to start, with `0` representing midnight UTC. " +
+					"Maintenance windows are typically three hours long starting from this " +
+					"hour. A `null` value means that no explicit maintenance window has been " +
+					"set and that maintenance is allowed to occur at any time. The hour is kept " +
+					"in state as a string, so that midnight is distinct from no window.",
+				// A string since SDK state can't tell an integer's null from 0, which is
+				// midnight here, so "" stands in for null instead
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9]|1[0-9]|2[0-3])$`), "expected an hour from 0 to 23"),
+			},
+			"source_cluster_id": {
+				Description: "The ID of a cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) to fork. The new cluster starts with a copy of the source cluster's data, " +
+					"at `recovery_target_time` when set or the latest available point otherwise. `major_version` must match the source cluster. Only used on create.",
+				// Not reported by the API, so imported clusters don't know their source
+				DiffSuppressFunc: suppressAfterCreate,
+				Optional:         true,
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.StringLenBetween(26, 26),
+			},
+			"recovery_target_time": {
+				Description:      "The point in time to restore the source cluster's data to, formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339). Must be within the source cluster's backup retention. Only used on create.",
+				DiffSuppressFunc: suppressAfterCreate,
+				Optional:         true,
+				RequiredWith:     []string{"source_cluster_id"},
+				Type:             schema.TypeString,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+			"suspended": {
+				Default:     false,
+				Description: "Whether the cluster is suspended, stopping its instances while keeping its storage. Changes wait for the cluster to finish suspending or resuming. Defaults to `false`",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			"tags": {
+				Description: "Map of tags for ownership and cost allocation, merged over the provider's `default_tags`.",
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"wait_until_ready": {
-				Description: "Treats the create operation as incomplete until the cluster reports a ready status. Defaults to `false`",
+				Description: "Treats the create operation as incomplete until the cluster reports a ready status. Creation fails if the cluster instead reports a failed state, begins to be destroyed, or the create timeout elapses. Defaults to `false`",
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
@@ -113,6 +182,11 @@ func resourceCluster() *schema.Resource {
 				Description: "The unique ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid)",
 				Type:        schema.TypeString,
 			},
+			"idempotency_key": {
+				Computed:    true,
+				Description: "The key sent with the create request when `idempotent_create` is enabled on the provider. Generated when the cluster is planned for creation and cleared once the create request completes, so that replacement clusters never reuse it.",
+				Type:        schema.TypeString,
+			},
 			"cpu": {
 				Computed:    true,
 				Description: "The number of CPU units on the cluster's instance",
@@ -123,21 +197,27 @@ func resourceCluster() *schema.Resource {
 				Description: "Creation time formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
 				Type:        schema.TypeString,
 			},
-			"maintenance_window_start": {
-				Computed: true,
-				Description: "The hour of day which a maintenance window can possibly start. " +
-					"This should be an integer from `0` to `23` representing the hour of day which " +
-					"maintenance is allowed to start, with `0` representing midnight UTC. " +
-					"Maintenance windows are typically three hours long starting from this " +
-					"hour. A `null` value means that no explicit maintenance window has been " +
-					"set and that maintenance is allowed to occur at any time.",
-				Type: schema.TypeInt,
-			},
 			"memory": {
 				Computed:    true,
 				Description: "The total amount of memory available on the cluster's instance in GB (gigabytes).",
 				Type:        schema.TypeFloat,
 			},
+			"replica_ids": {
+				Computed:    true,
+				Description: "The IDs of the cluster's read replicas.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags_all": {
+				Computed:    true,
+				Description: "Every tag on the cluster, including those from the provider's `default_tags`.",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"updated_at": {
 				Computed:    true,
 				Description: "Time at which the cluster was last updated formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
@@ -156,42 +236,104 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	req := bridgeapi.CreateRequest{
 		HighAvailability: d.Get("is_ha").(bool),
 		Name:             d.Get("name").(string),
+		NetworkID:        d.Get("network_id").(string),
 		PGMajorVersion:   d.Get("major_version").(int),
 		Plan:             d.Get("plan_id").(string),
 		Provider:         d.Get("provider_id").(string),
 		Region:           d.Get("region_id").(string),
 		StorageGB:        d.Get("storage").(int),
+		Tags:             mergeTags(client.DefaultTags(), tagMap(d.Get("tags"))),
 		TeamID:           d.Get("team_id").(string),
 	}
 
+	if sourceID, ok := d.GetOk("source_cluster_id"); ok {
+		req.SourceClusterID = sourceID.(string)
+
+		// Forks keep the source's data directory, so can't change versions on the way
+		source, err := client.ClusterDetail(req.SourceClusterID)
+		if err != nil {
+			return diag.Errorf("error looking up source cluster: %s", err)
+		}
+		if source.PGMajorVersion != req.PGMajorVersion {
+			return diag.Errorf("major_version %d does not match source cluster %s, which is on major version %d", req.PGMajorVersion, source.ID, source.PGMajorVersion)
+		}
+
+		if target, ok := d.GetOk("recovery_target_time"); ok {
+			// Already validated as RFC 3339
+			targetTime, _ := time.Parse(time.RFC3339, target.(string))
+			req.RecoveryTargetTime = &targetTime
+		}
+	}
+
 	tflog.Trace(ctx, "sending cluster resource create request to API")
 
-	id, err := client.CreateCluster(req)
+	// CustomizeDiff isn't run when planning the replacement of a tainted cluster, leaving
+	// the plan with the key from state, which is cleared after create for that reason
+	idemKey := d.Get("idempotency_key").(string)
+	if idemKey == "" {
+		idemKey = uuid.NewString()
+	}
+
+	submitted := time.Now()
+	id, err := client.CreateCluster(ctx, req, idemKey)
+	if errors.Is(err, bridgeapi.ErrorAmbiguous) {
+		// The cluster may exist despite the error, adopt it rather than leaving
+		// it running outside of terraform's knowledge
+		tflog.Warn(ctx, "cluster create outcome unknown, searching team for the cluster", map[string]interface{}{
+			"error": err,
+		})
+
+		adoptID, findErr := findCreatedCluster(ctx, client, req, submitted)
+		if findErr != nil {
+			return diag.Errorf("failed to create cluster: %s, and while checking for the cluster: %s", err, findErr)
+		} else if adoptID != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Cluster adopted after ambiguous create failure",
+				Detail: fmt.Sprintf("The create request for cluster %q failed without a definitive response (%s), "+
+					"but a matching cluster with ID %s was found in the team and has been added to the state.", req.Name, err, adoptID),
+			})
+			id, err = adoptID, nil
+		}
+	}
 	if err != nil {
 		return diag.Errorf("failed to create cluster: %s", err)
 	}
 
 	d.SetId(id)
 
+	// The key has served its purpose and must not be sent for any other cluster
+	err = d.Set("idempotency_key", "")
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	tflog.Trace(ctx, "successfully submitted create request")
 
 	if waitReady := d.Get("wait_until_ready").(bool); waitReady {
-		delay := 10 * time.Second // Set to terraform's notification status interval on create
-		for ready, elapsed := false, time.Duration(0); !ready; elapsed += delay {
-			status, err := client.ClusterStatus(id)
-			if err != nil {
-				tflog.Error(ctx, "error obtaining cluster ready status", map[string]interface{}{
-					"error": err,
-					"time":  elapsed.String(),
-				})
-			}
-			ready = (status.State == "ready")
-			if !ready {
-				// terraform handles showing elapsed time, we don't need to here
-				time.Sleep(delay)
-			} else {
-				tflog.Debug(ctx, "Completed waiting on cluster ready, "+elapsed.String()+" elapsed.")
-			}
+		if err := waitForClusterState(ctx, client, id, bridgeapi.ClusterStateReady); err != nil {
+			// ID is already set, so the cluster is tracked (as tainted) even though the wait failed
+			return append(diags, diag.Errorf("error waiting for cluster to become ready: %s", err)...)
+		}
+	}
+
+	// Not part of the create request, so set separately once the cluster exists
+	if start := maintWindowStart(d); start != nil {
+		err := client.UpdateCluster(id, bridgeapi.ClusterUpdateRequest{
+			MaintWindowStart: start,
+		})
+		if err != nil {
+			return append(diags, diag.Errorf("error while setting cluster maintenance window: %s", err)...)
+		}
+	}
+
+	if d.Get("suspended").(bool) {
+		// The cluster can only be suspended once it has finished provisioning
+		if err := waitForClusterState(ctx, client, id, bridgeapi.ClusterStateReady); err != nil {
+			return append(diags, diag.Errorf("error waiting for cluster to become ready before suspending: %s", err)...)
+		}
+		if err := setClusterSuspended(ctx, client, id, true); err != nil {
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
@@ -201,6 +343,184 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+// waitForClusterState polls the cluster status until it reports the target state. Waiting
+// stops early with an error when the cluster settles in some other state, fails, or begins
+// to be destroyed, since none of those will reach the target without intervention.
+func waitForClusterState(ctx context.Context, client *bridgeapi.Client, id string, target bridgeapi.ClusterState) error {
+	delay := clusterPollInterval
+	var state bridgeapi.ClusterState
+	for elapsed := time.Duration(0); ; elapsed += delay {
+		status, err := client.ClusterStatus(id)
+		if err != nil {
+			tflog.Error(ctx, "error obtaining cluster status", map[string]interface{}{
+				"error": err,
+				"time":  elapsed.String(),
+			})
+		} else {
+			state = status.State
+			switch {
+			case state == target:
+				tflog.Debug(ctx, "Completed waiting on cluster "+string(target)+", "+elapsed.String()+" elapsed.")
+				return nil
+			case state.IsFailed():
+				return fmt.Errorf("cluster %s reported a failed state", id)
+			case state == bridgeapi.ClusterStateDestroying:
+				return fmt.Errorf("cluster %s is being destroyed", id)
+			case state.IsTerminal():
+				return fmt.Errorf("cluster %s settled in state %s instead of %s", id, state, target)
+			case !state.Known():
+				// Newer API states are waited on, bounded by the operation timeout
+				tflog.Warn(ctx, "unrecognized cluster state while waiting", map[string]interface{}{
+					"state": string(state),
+				})
+			}
+		}
+
+		// terraform handles showing elapsed time, we don't need to here
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for cluster %s to become %s, last state: %s", id, target, state)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// maintWindowStart returns the configured maintenance window start, or nil when
+// unset. Hour 0 is a valid window, so the zero value can't stand in for null.
+func maintWindowStart(d *schema.ResourceData) *int {
+	start, err := strconv.Atoi(d.Get("maintenance_window_start").(string))
+	if err != nil {
+		// Only unset values fail, others are validated as hours
+		return nil
+	}
+	return &start
+}
+
+// waitForClusterStateChange polls the cluster status until it reports a state other than
+// from. Actions are carried out in the background, so the status keeps reporting the
+// starting state for a while after they are accepted.
+func waitForClusterStateChange(ctx context.Context, client *bridgeapi.Client, id string, from bridgeapi.ClusterState) error {
+	delay := clusterPollInterval
+	for elapsed := time.Duration(0); ; elapsed += delay {
+		status, err := client.ClusterStatus(id)
+		if err != nil {
+			tflog.Error(ctx, "error obtaining cluster status", map[string]interface{}{
+				"error": err,
+				"time":  elapsed.String(),
+			})
+		} else if status.State != from {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for cluster %s to leave state %s", id, from)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// setClusterSuspended suspends or resumes the cluster, then waits for it to settle
+// in the resulting state
+func setClusterSuspended(ctx context.Context, client *bridgeapi.Client, id string, suspend bool) error {
+	action, from, target := "resume", bridgeapi.ClusterStateSuspended, bridgeapi.ClusterStateReady
+	request := client.ResumeCluster
+	if suspend {
+		action, from, target = "suspend", bridgeapi.ClusterStateReady, bridgeapi.ClusterStateSuspended
+		request = client.SuspendCluster
+	}
+
+	if err := request(id); err != nil {
+		return fmt.Errorf("error requesting cluster %s: %w", action, err)
+	}
+
+	// Both states are terminal, so waiting on the target straight away would fail
+	// on the starting state
+	if err := waitForClusterStateChange(ctx, client, id, from); err != nil {
+		return fmt.Errorf("error waiting for cluster %s to start: %w", action, err)
+	}
+	if err := waitForClusterState(ctx, client, id, target); err != nil {
+		return fmt.Errorf("error waiting for cluster %s to finish: %w", action, err)
+	}
+	return nil
+}
+
+// findCreatedCluster looks for a cluster created by cr after the given time, returning
+// its ID or an empty string when none is found. Names are unique within a team, so a
+// cluster with the requested name but differing attributes is reported as an error.
+func findCreatedCluster(ctx context.Context, client *bridgeapi.Client, cr bridgeapi.CreateRequest, since time.Time) (string, error) {
+	// Allow for clock differences between the API and the local machine
+	since = since.Add(-1 * time.Minute)
+
+	delay := 10 * time.Second // Allows time for an accepted create to show up in listings
+	for attempt := 1; attempt <= 3; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return "", fmt.Errorf("stopped searching for cluster %q: %w", cr.Name, ctx.Err())
+			case <-time.After(delay):
+			}
+		}
+
+		clusters, err := client.ClustersForTeam(cr.TeamID)
+		if err != nil {
+			return "", err
+		}
+
+		for _, cd := range clusters {
+			if cd.Name != cr.Name || cd.Created.Before(since) {
+				continue
+			}
+
+			if cd.PlanID != cr.Plan || cd.ProviderID != cr.Provider || cd.RegionID != cr.Region ||
+				cd.StorageGB != cr.StorageGB || cd.HighAvailability != cr.HighAvailability ||
+				cd.PGMajorVersion != cr.PGMajorVersion || (cr.NetworkID != "" && cd.NetworkID != cr.NetworkID) {
+				return "", fmt.Errorf("cluster %s named %q exists with attributes that differ from the configuration, import or remove it before retrying", cd.ID, cd.Name)
+			}
+
+			return cd.ID, nil
+		}
+
+		tflog.Debug(ctx, "no matching cluster found in team", map[string]interface{}{
+			"attempt": attempt,
+		})
+	}
+
+	return "", nil
+}
+
+// resourceClusterCustomizeDiff plans a random idempotency key for clusters pending
+// creation. The SDK offers no private state to write to before create is called,
+// so the key lives in the plan instead, which keeps it fixed for the whole apply.
+func resourceClusterCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		if err := d.SetNew("idempotency_key", uuid.NewString()); err != nil {
+			return err
+		}
+	}
+
+	// Show the effect of default tags in the plan
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	var defaults map[string]string
+	if client, ok := meta.(*bridgeapi.Client); ok && client != nil {
+		defaults = client.DefaultTags()
+	}
+	merged := mergeTags(defaults, tagMap(d.Get("tags")))
+	if !reflect.DeepEqual(merged, tagMap(d.Get("tags_all"))) {
+		return d.SetNew("tags_all", merged)
+	}
+
+	return nil
+}
+
+// suppressAfterCreate ignores changes to create-only arguments once the resource
+// exists, since they aren't read back from the API
+func suppressAfterCreate(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
 func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bridgeapi.Client)
 
@@ -233,9 +553,16 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
-	err = d.Set("maintenance_window_start", cd.MaintWindowStart)
-	if err != nil {
-		diags = append(diags, diag.FromErr(err)...)
+	// Left null when there's no window, or cleared if one was removed outside terraform
+	if cd.MaintWindowStart != nil || d.Get("maintenance_window_start").(string) != "" {
+		var start string
+		if cd.MaintWindowStart != nil {
+			start = strconv.Itoa(*cd.MaintWindowStart)
+		}
+		err = d.Set("maintenance_window_start", start)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
 	}
 	err = d.Set("memory", cd.MemoryGB)
 	if err != nil {
@@ -245,6 +572,10 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("network_id", cd.NetworkID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("plan_id", cd.PlanID)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -257,10 +588,27 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	err = d.Set("replica_ids", replicaIDs(cd))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("storage", cd.StorageGB)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
+	// A cluster on its way to being suspended already matches a suspended configuration
+	err = d.Set("suspended", cd.State == bridgeapi.ClusterStateSuspended || cd.State == bridgeapi.ClusterStateSuspending)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("tags", resourceTags(cd.Tags, client.DefaultTags(), tagMap(d.Get("tags"))))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("tags_all", cd.Tags)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 	err = d.Set("team_id", cd.TeamID)
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)...)
@@ -279,25 +627,53 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	clusterID := d.Id()
 
-	noUpgSupport := []string{"provider_id", "region_id", "team_id", "wait_until_ready"}
+	noUpgSupport := []string{"network_id", "provider_id", "region_id", "team_id", "wait_until_ready"}
 	for _, key := range noUpgSupport {
 		if d.HasChange(key) {
 			diags = append(diags, diag.Errorf("provider does not support in-place update for [%s]", key)...)
 		}
 	}
+	// Upgrades carry on in the background, possibly until the maintenance window, and
+	// would be cut short by suspending
+	if d.HasChange("suspended") && d.Get("suspended").(bool) && d.HasChanges("plan_id", "is_ha", "storage", "major_version") {
+		diags = append(diags, diag.Errorf("cannot suspend a cluster while changing plan_id, is_ha, storage, or major_version, apply those changes first")...)
+	}
 	// If unsupported fields have changed, error out so the user can correct them before applying good changes
 	if len(diags) > 0 {
 		return diags
 	}
 
+	suspend := d.Get("suspended").(bool)
+
+	// Resume before anything else, since other changes need a running cluster
+	if d.HasChange("suspended") && !suspend {
+		if err := setClusterSuspended(ctx, client, clusterID, false); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	// Update call on client
-	if d.HasChange("name") {
-		newName := d.Get("name").(string)
-		err := client.UpdateCluster(clusterID, bridgeapi.ClusterUpdateRequest{
-			Name: &newName,
-		})
+	if d.HasChanges("name", "maintenance_window_start") {
+		req := bridgeapi.ClusterUpdateRequest{}
+		if d.HasChange("name") {
+			newName := d.Get("name").(string)
+			req.Name = &newName
+		}
+		if d.HasChange("maintenance_window_start") {
+			req.MaintWindowStart = maintWindowStart(d)
+			req.ClearMaintWindow = req.MaintWindowStart == nil
+		}
+
+		err := client.UpdateCluster(clusterID, req)
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while updating cluster: %s", err)...)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		err := client.SetClusterTags(clusterID, mergeTags(client.DefaultTags(), tagMap(d.Get("tags"))))
 		if err != nil {
-			diags = append(diags, diag.Errorf("error while updating cluster name: %s", err)...)
+			diags = append(diags, diag.Errorf("error while updating cluster tags: %s", err)...)
 		}
 	}
 
@@ -331,6 +707,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// Suspend last, and only when everything else applied, so changes aren't left pending
+	if d.HasChange("suspended") && suspend && len(diags) == 0 {
+		if err := setClusterSuspended(ctx, client, clusterID, true); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	readDiag := resourceClusterRead(ctx, d, meta)
 	diags = append(diags, readDiag...)
 
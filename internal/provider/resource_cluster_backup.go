@@ -0,0 +1,217 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceClusterBackup() *schema.Resource {
+	return &schema.Resource{
+		Description: "On-demand backup of a cluster. A backup is taken when the resource is created and again whenever " +
+			"`triggers` changes. Creation waits for the backup to finish, bounded by the create timeout. Destroying " +
+			"the resource leaves the backup in place until it ages out of the cluster's backup retention. Once it has " +
+			"aged out, the resource keeps the backup's last known details rather than taking a new one.",
+
+		CreateContext: resourceClusterBackupCreate,
+		ReadContext:   resourceClusterBackupRead,
+		DeleteContext: resourceClusterBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) to back up.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that takes a new backup whenever it changes.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the backup in the form `<cluster_id>/<name>`.",
+				Type:        schema.TypeString,
+			},
+			"name": {
+				Computed:    true,
+				Description: "The name of the backup.",
+				Type:        schema.TypeString,
+			},
+			"started_at": {
+				Computed:    true,
+				Description: "Time the backup started formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
+				Type:        schema.TypeString,
+			},
+			"finished_at": {
+				Computed:    true,
+				Description: "Time the backup finished formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339). Usable as a `recovery_target_time` once the backup completes. Null while the backup is in progress.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceClusterBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	clusterID := d.Get("cluster_id").(string)
+
+	tflog.Trace(ctx, "sending cluster backup request to API")
+
+	backup, err := client.StartClusterBackup(clusterID)
+	if err != nil {
+		return diag.Errorf("failed to start cluster backup: %s", err)
+	}
+
+	d.SetId(joinID(clusterID, backup.Name))
+
+	if err := waitForClusterBackup(ctx, client, clusterID, backup.Name); err != nil {
+		// ID is already set, so the backup is tracked (as tainted) even though the wait failed
+		return append(diags, diag.Errorf("error waiting for backup to finish: %s", err)...)
+	}
+
+	readDiag := resourceClusterBackupRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+// waitForClusterBackup polls until the backup finishes. The API reports no failure
+// state for backups, so a backup that drops out of the listing once seen, or a cluster
+// that fails or begins to be destroyed, is treated as a failed backup.
+func waitForClusterBackup(ctx context.Context, client *bridgeapi.Client, clusterID, name string) error {
+	delay := 10 * time.Second
+	seen := false
+	for elapsed := time.Duration(0); ; elapsed += delay {
+		backup, err := client.ClusterBackup(clusterID, name)
+		switch {
+		case errors.Is(err, bridgeapi.ErrorNotFound) && seen:
+			return fmt.Errorf("backup %s of cluster %s is no longer listed, it may have failed", name, clusterID)
+		case err != nil:
+			// Listings may briefly lag behind the create
+			tflog.Error(ctx, "error obtaining cluster backup", map[string]interface{}{
+				"error": err,
+				"time":  elapsed.String(),
+			})
+		case backup.Finished != nil:
+			tflog.Debug(ctx, "Completed waiting on cluster backup, "+elapsed.String()+" elapsed.")
+			return nil
+		default:
+			seen = true
+		}
+
+		status, err := client.ClusterStatus(clusterID)
+		if err != nil {
+			tflog.Error(ctx, "error obtaining cluster status", map[string]interface{}{
+				"error": err,
+				"time":  elapsed.String(),
+			})
+		} else if status.State.IsFailed() || status.State == bridgeapi.ClusterStateDestroying {
+			return fmt.Errorf("cluster %s entered state %s during backup %s", clusterID, status.State, name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for backup %s of cluster %s", name, clusterID)
+		case <-time.After(delay):
+		}
+	}
+}
+
+func resourceClusterBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	parts, err := splitID(d.Id(), "cluster_id", "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, name := parts[0], parts[1]
+
+	backup, err := client.ClusterBackup(clusterID, name)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		_, err = client.ClusterDetail(clusterID)
+		if errors.Is(err, bridgeapi.ErrorNotFound) {
+			tflog.Warn(ctx, "cluster no longer exists, removing backup from state", map[string]interface{}{
+				"id": d.Id(),
+			})
+			d.SetId("")
+			return nil
+		} else if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Backups age out of the cluster's retention, which isn't a reason to take
+		// another, so the state keeps what was known about it
+		tflog.Info(ctx, "cluster backup has expired from retention, keeping state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("name", backup.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("started_at", backup.Started.Format(time.RFC3339))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	// Don't set finished_at while the backup is in progress, leaving it null
+	if backup.Finished != nil {
+		err = d.Set("finished_at", backup.Finished.Format(time.RFC3339))
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceClusterBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Backups can't be deleted, they expire with the cluster's retention
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var clusterOperationKinds = []string{"failover", "restart"}
+
+func resourceClusterOperation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Runs an operation on a cluster when the resource is created and again whenever `triggers` changes. " +
+			"Creation waits for the cluster to return to ready, bounded by the create timeout. Destroying the resource " +
+			"only removes it from the state.",
+
+		CreateContext: resourceClusterOperationCreate,
+		ReadContext:   resourceClusterOperationRead,
+		DeleteContext: resourceClusterOperationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) to run the operation on.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"kind": {
+				Description:  "The operation to run, either `restart` to restart Postgres or `failover` to promote the standby of a high availability cluster.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(clusterOperationKinds, false),
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that runs the operation again whenever it changes.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the operation in the form `<cluster_id>/<kind>`.",
+				Type:        schema.TypeString,
+			},
+			"completed_at": {
+				Computed:    true,
+				Description: "Time the cluster returned to ready after the operation formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceClusterOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	clusterID := d.Get("cluster_id").(string)
+	kind := d.Get("kind").(string)
+
+	tflog.Trace(ctx, "sending cluster "+kind+" request to API")
+
+	var err error
+	switch kind {
+	case "failover":
+		err = client.FailoverCluster(clusterID)
+	case "restart":
+		err = client.RestartCluster(clusterID)
+	}
+	if err != nil {
+		return diag.Errorf("failed to %s cluster: %s", kind, err)
+	}
+
+	d.SetId(joinID(clusterID, kind))
+
+	if err := waitForClusterOperation(ctx, client, clusterID); err != nil {
+		// ID is already set, so the operation is tracked (as tainted) and runs again on the next apply
+		return append(diags, diag.Errorf("error waiting for cluster %s to complete: %s", kind, err)...)
+	}
+
+	err = d.Set("completed_at", time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	readDiag := resourceClusterOperationRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+// waitForClusterOperation waits for a requested restart or failover to get underway,
+// since the status still reports ready for a while after the request, then for the
+// cluster to be ready again
+func waitForClusterOperation(ctx context.Context, client *bridgeapi.Client, clusterID string) error {
+	if err := waitForClusterStateChange(ctx, client, clusterID, bridgeapi.ClusterStateReady); err != nil {
+		return err
+	}
+	return waitForClusterState(ctx, client, clusterID, bridgeapi.ClusterStateReady)
+}
+
+func resourceClusterOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	// Operations leave nothing behind to read, only check the cluster is still around
+	_, err := client.ClusterDetail(d.Get("cluster_id").(string))
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "cluster no longer exists, removing operation from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading cluster for operation: %w", err))
+	}
+
+	return nil
+}
+
+func resourceClusterOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Operations can't be undone
+	d.SetId("")
+
+	return nil
+}
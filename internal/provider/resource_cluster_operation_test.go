@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+)
+
+func TestWaitForClusterOperation(t *testing.T) {
+	defer func(interval time.Duration) { clusterPollInterval = interval }(clusterPollInterval)
+	clusterPollInterval = time.Millisecond
+
+	api := &fakeClusterAPI{states: []bridgeapi.ClusterState{
+		bridgeapi.ClusterStateReady,
+		bridgeapi.ClusterStateReady,
+		bridgeapi.ClusterStateRestarting,
+		bridgeapi.ClusterStateReady,
+	}}
+	client := newFakeClusterClient(t, api)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := waitForClusterOperation(ctx, client, "abc"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(api.states) != 1 {
+		t.Errorf("returned before the operation finished, %d states left", len(api.states))
+	}
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceClusterParameters() *schema.Resource {
+	return &schema.Resource{
+		Description: "Postgres configuration parameters for a cluster. Only the parameters in `parameters` are managed, " +
+			"others keep their current values. Parameters removed from the map, or all managed parameters when the " +
+			"resource is destroyed, are reset to their defaults.",
+
+		CreateContext: resourceClusterParametersCreate,
+		ReadContext:   resourceClusterParametersRead,
+		UpdateContext: resourceClusterParametersUpdate,
+		DeleteContext: resourceClusterParametersDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"parameters": {
+				Description: "Map of Postgres parameter names to values, for example `work_mem = \"64MB\"`.",
+				Required:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"wait_for_restart": {
+				Description: "Restarts the cluster when changed parameters require it, treating the apply as incomplete " +
+					"until they have been applied and the cluster is ready again, bounded by the create or update timeout. " +
+					"Otherwise a warning lists the parameters awaiting a restart, which happens at the next restart of the " +
+					"cluster, for example by a `crunchybridge_cluster_operation`. Defaults to `false`",
+				Optional: true,
+				Type:     schema.TypeBool,
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+			"pending_restart": {
+				Computed:    true,
+				Description: "Names of the cluster's parameters whose values only take effect after the cluster restarts.",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// pendingRestart lists the parameters waiting on a restart, ordered by name
+func pendingRestart(params []bridgeapi.ClusterParameter) []string {
+	names := []string{}
+	for _, param := range params {
+		if param.PendingRestart {
+			names = append(names, param.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyClusterParameters sends the parameters changed since the last apply, resetting
+// any that were removed from the configuration
+func applyClusterParameters(ctx context.Context, d *schema.ResourceData, client *bridgeapi.Client) diag.Diagnostics {
+	clusterID := d.Get("cluster_id").(string)
+	oldRaw, newRaw := d.GetChange("parameters")
+	oldParams, newParams := oldRaw.(map[string]interface{}), newRaw.(map[string]interface{})
+
+	updates := []bridgeapi.ClusterParameterUpdate{}
+	for name, value := range newParams {
+		if old, ok := oldParams[name]; !ok || old != value {
+			newValue := value.(string)
+			updates = append(updates, bridgeapi.ClusterParameterUpdate{Name: name, Value: &newValue})
+		}
+	}
+	for name := range oldParams {
+		if _, ok := newParams[name]; !ok {
+			updates = append(updates, bridgeapi.ClusterParameterUpdate{Name: name})
+		}
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Name < updates[j].Name
+	})
+
+	tflog.Trace(ctx, "sending cluster parameter update request to API", map[string]interface{}{
+		"count": len(updates),
+	})
+
+	params, err := client.UpdateClusterParameters(clusterID, updates)
+	if err != nil {
+		return diag.Errorf("failed to update cluster parameters: %s", err)
+	}
+
+	pending := pendingRestart(params)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if d.Get("wait_for_restart").(bool) {
+		if err := restartClusterForParameters(ctx, client, clusterID); err != nil {
+			return diag.Errorf("error restarting cluster to apply parameters: %s", err)
+		}
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Cluster parameters awaiting restart",
+		Detail: fmt.Sprintf("The following parameters on cluster %s only take effect after the cluster restarts: %s",
+			clusterID, strings.Join(pending, ", ")),
+	}}
+}
+
+// restartClusterForParameters restarts the cluster, then waits until no parameter
+// is pending a restart and the cluster is ready to accept connections again
+func restartClusterForParameters(ctx context.Context, client *bridgeapi.Client, clusterID string) error {
+	if err := client.RestartCluster(clusterID); err != nil {
+		return err
+	}
+
+	delay := clusterPollInterval
+	for elapsed := time.Duration(0); ; elapsed += delay {
+		params, err := client.ClusterParameters(clusterID)
+		if err != nil {
+			tflog.Error(ctx, "error obtaining cluster parameters", map[string]interface{}{
+				"error": err,
+				"time":  elapsed.String(),
+			})
+		} else if len(pendingRestart(params)) == 0 {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for cluster %s to apply parameters", clusterID)
+		case <-time.After(delay):
+		}
+	}
+
+	return waitForClusterState(ctx, client, clusterID, bridgeapi.ClusterStateReady)
+}
+
+func resourceClusterParametersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	d.SetId(d.Get("cluster_id").(string))
+
+	diags = append(diags, applyClusterParameters(ctx, d, client)...)
+	if diag.Diagnostics(diags).HasError() {
+		return diags
+	}
+
+	readDiag := resourceClusterParametersRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceClusterParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	params, err := client.ClusterParameters(d.Id())
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "cluster no longer exists, removing parameters from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	// Only report the managed parameters, a parameter reset outside of terraform
+	// is left out so that it shows as a difference
+	managed := d.Get("parameters").(map[string]interface{})
+	current := map[string]string{}
+	for _, param := range params {
+		if _, ok := managed[param.Name]; ok {
+			current[param.Name] = param.Value
+		}
+	}
+
+	err = d.Set("cluster_id", d.Id())
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("parameters", current)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("pending_restart", pendingRestart(params))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceClusterParametersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	if d.HasChange("parameters") {
+		diags = append(diags, applyClusterParameters(ctx, d, client)...)
+	}
+
+	readDiag := resourceClusterParametersRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceClusterParametersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	updates := []bridgeapi.ClusterParameterUpdate{}
+	for name := range d.Get("parameters").(map[string]interface{}) {
+		updates = append(updates, bridgeapi.ClusterParameterUpdate{Name: name})
+	}
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Name < updates[j].Name
+	})
+
+	if len(updates) > 0 {
+		_, err := client.UpdateClusterParameters(d.Id(), updates)
+		if err != nil && !errors.Is(err, bridgeapi.ErrorNotFound) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceClusterReplica() *schema.Resource {
+	return &schema.Resource{
+		Description: "Read replica resource for the Crunchy Bridge Terraform Provider. Replicas follow their " +
+			"source cluster and can be placed in another region or provider.",
+
+		CreateContext: resourceClusterReplicaCreate,
+		ReadContext:   resourceClusterReplicaRead,
+		UpdateContext: resourceClusterReplicaUpdate,
+		DeleteContext: resourceClusterReplicaDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"source_cluster_id": {
+				Description:  "The ID of the cluster to replicate in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"name": {
+				Computed:     true,
+				Description:  "A human-readable name for the replica. Defaults to a name derived from the source cluster.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(5, 50),
+			},
+			"plan_id": {
+				Computed:    true,
+				Description: "The ID of the [replica's plan](https://docs.crunchybridge.com/concepts/plans-pricing/). Defaults to the source cluster's plan.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"provider_id": {
+				Computed:     true,
+				Description:  "The [cloud provider](https://docs.crunchybridge.com/api/provider) where the replica is located. Defaults to the source cluster's provider, allows `aws`, `gcp`, or `azure`",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"aws", "gcp", "azure"}, false),
+			},
+			"region_id": {
+				Computed:    true,
+				Description: "The [provider region](https://docs.crunchybridge.com/api/provider#region) where the replica is located. Defaults to the source cluster's region.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"storage": {
+				Computed:     true,
+				Description:  "The amount of storage available to the replica in GB (gigabytes). Defaults to the source cluster's storage.",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(10),
+			},
+			"wait_until_ready": {
+				Description: "Treats the create operation as incomplete until the replica reports a ready status. Creation fails if the replica instead reports a failed state, begins to be destroyed, or the create timeout elapses. Defaults to `false`",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The unique ID of the replica in [EID format](https://docs.crunchybridge.com/api-concepts/eid)",
+				Type:        schema.TypeString,
+			},
+			"created_at": {
+				Computed:    true,
+				Description: "Creation time formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
+				Type:        schema.TypeString,
+			},
+			"major_version": {
+				Computed:    true,
+				Description: "The replica's major Postgres version, which follows the source cluster.",
+				Type:        schema.TypeInt,
+			},
+			"team_id": {
+				Computed:    true,
+				Description: "The ID of the parent [team](https://docs.crunchybridge.com/concepts/teams/) for the replica.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// replicaIDs lists the IDs of the cluster's replicas
+func replicaIDs(cd bridgeapi.ClusterDetail) []string {
+	ids := []string{}
+	for _, replica := range cd.Replicas {
+		ids = append(ids, replica.ID)
+	}
+	return ids
+}
+
+func resourceClusterReplicaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	req := bridgeapi.ReplicaCreateRequest{
+		Name:      d.Get("name").(string),
+		Plan:      d.Get("plan_id").(string),
+		Provider:  d.Get("provider_id").(string),
+		Region:    d.Get("region_id").(string),
+		StorageGB: d.Get("storage").(int),
+	}
+
+	tflog.Trace(ctx, "sending replica create request to API")
+
+	replica, err := client.CreateReplica(d.Get("source_cluster_id").(string), req)
+	if err != nil {
+		return diag.Errorf("failed to create replica: %s", err)
+	}
+
+	d.SetId(replica.ID)
+
+	if waitReady := d.Get("wait_until_ready").(bool); waitReady {
+		if err := waitForClusterState(ctx, client, replica.ID, bridgeapi.ClusterStateReady); err != nil {
+			// ID is already set, so the replica is tracked (as tainted) even though the wait failed
+			return append(diags, diag.Errorf("error waiting for replica to become ready: %s", err)...)
+		}
+	}
+
+	readDiag := resourceClusterReplicaRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceClusterReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	cd, err := client.ClusterDetail(d.Id())
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "replica no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+	if cd.ParentID == nil {
+		return diag.Errorf("cluster %s is not a replica", cd.ID)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("source_cluster_id", *cd.ParentID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("created_at", cd.Created.Format(time.RFC3339))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("major_version", cd.PGMajorVersion)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("name", cd.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("plan_id", cd.PlanID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("provider_id", cd.ProviderID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("region_id", cd.RegionID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("storage", cd.StorageGB)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("team_id", cd.TeamID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceClusterReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	replicaID := d.Id()
+
+	noUpgSupport := []string{"provider_id", "region_id", "source_cluster_id", "wait_until_ready"}
+	for _, key := range noUpgSupport {
+		if d.HasChange(key) {
+			diags = append(diags, diag.Errorf("provider does not support in-place update for [%s]", key)...)
+		}
+	}
+	// If unsupported fields have changed, error out so the user can correct them before applying good changes
+	if len(diags) > 0 {
+		return diags
+	}
+
+	if d.HasChange("name") {
+		newName := d.Get("name").(string)
+		err := client.UpdateCluster(replicaID, bridgeapi.ClusterUpdateRequest{
+			Name: &newName,
+		})
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while updating replica name: %s", err)...)
+		}
+	}
+
+	if d.HasChanges("plan_id", "storage") {
+		req := bridgeapi.ClusterUpgradeRequest{}
+		var newPlan string
+		var newDisk int
+
+		if d.HasChange("plan_id") {
+			newPlan = d.Get("plan_id").(string)
+			req.PlanID = &newPlan
+		}
+		if d.HasChange("storage") {
+			newDisk = d.Get("storage").(int)
+			req.StorageGB = &newDisk
+		}
+
+		err := client.UpgradeCluster(replicaID, req)
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while upgrading replica: %s", err)...)
+		}
+	}
+
+	readDiag := resourceClusterReplicaRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceClusterReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.DeleteCluster(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// userRolePrefix starts the names of roles belonging to accounts, as opposed to
+// the system roles the API creates with the cluster
+const userRolePrefix = "u_"
+
+func resourceClusterRole() *schema.Resource {
+	return &schema.Resource{
+		Description: "Cluster role resource for the Crunchy Bridge Terraform Provider. Manages the " +
+			"`u_` prefixed user role belonging to the authenticated account on a cluster.",
+
+		CreateContext: resourceClusterRoleCreate,
+		ReadContext:   resourceClusterRoleRead,
+		DeleteContext: resourceClusterRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterRoleImport,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) on which to create the role.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the role in the form `<cluster_id>/<name>`, also used for import.",
+				Type:        schema.TypeString,
+			},
+			"account_id": {
+				Computed:    true,
+				Description: "The ID of the account the role belongs to in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+			"name": {
+				Computed:    true,
+				Description: "Name of the role in Postgres.",
+				Type:        schema.TypeString,
+			},
+			"password": {
+				Computed:    true,
+				Description: "Password of the role in Postgres.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"team_id": {
+				Computed:    true,
+				Description: "The ID of the associated cluster's parent team in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+			"uri": {
+				Computed:    true,
+				Description: "A full URI usable as a Postgres connection string for the role.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// clusterRoleID joins the cluster ID and role name, since role names are only
+// unique within their cluster
+func clusterRoleID(clusterID, name string) string {
+	return joinID(clusterID, name)
+}
+
+func parseClusterRoleID(id string) (string, string, error) {
+	parts, err := splitID(id, "cluster_id", "name")
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// checkUserRole rejects system roles such as `postgres` and `application`, which
+// destroying the resource would otherwise attempt to delete
+func checkUserRole(name string) error {
+	if !strings.HasPrefix(name, userRolePrefix) {
+		return fmt.Errorf("role %q is not a user role, only `u_` prefixed roles can be managed", name)
+	}
+	return nil
+}
+
+func resourceClusterRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_, name, err := parseClusterRoleID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkUserRole(name); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceClusterRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	clusterID := d.Get("cluster_id").(string)
+
+	tflog.Trace(ctx, "sending cluster role create request to API")
+
+	role, err := client.CreateClusterRole(clusterID)
+	if err != nil {
+		return diag.Errorf("failed to create cluster role: %s", err)
+	}
+
+	d.SetId(clusterRoleID(clusterID, role.Name))
+
+	readDiag := resourceClusterRoleRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceClusterRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	clusterID, name, err := parseClusterRoleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = checkUserRole(name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, err := client.ClusterRole(clusterID, name)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "cluster role no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("cluster_id", clusterID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	// Only system roles lack an account, but don't store "" if one slips through
+	if role.AccountID != nil {
+		err = d.Set("account_id", *role.AccountID)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	err = d.Set("name", role.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("password", role.Password)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("team_id", role.TeamID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("uri", role.URI)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceClusterRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	clusterID, name, err := parseClusterRoleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteClusterRole(clusterID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
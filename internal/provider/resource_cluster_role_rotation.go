@@ -0,0 +1,226 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceClusterRoleRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Password rotation for a cluster role, including the `postgres` superuser and `application` roles. " +
+			"The password is rotated when the resource is created, on the first apply after `rotation_days` have elapsed " +
+			"since the last rotation, and whenever `rotate_triggers` changes. Destroying the resource leaves the role and " +
+			"its current password in place.",
+
+		CreateContext: resourceClusterRoleRotationCreate,
+		ReadContext:   resourceClusterRoleRotationRead,
+		UpdateContext: resourceClusterRoleRotationUpdate,
+		DeleteContext: resourceClusterRoleRotationDelete,
+
+		CustomizeDiff: resourceClusterRoleRotationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"role_name": {
+				Description: "Name of the role whose password is rotated. `postgres`, `application`, or a `u_` prefixed user role.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"rotation_days": {
+				Description:  "Number of days after which the next apply rotates the password. Only `rotate_triggers` cause rotation when unset.",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rotate_triggers": {
+				Description: "Arbitrary map of values that rotates the password whenever it changes.",
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the rotated role in the form `<cluster_id>/<role_name>`.",
+				Type:        schema.TypeString,
+			},
+			"last_rotated_at": {
+				Computed:    true,
+				Description: "Time of the last rotation performed by this resource formatted as [RFC 3339](https://datatracker.ietf.org/doc/html/rfc3339).",
+				Type:        schema.TypeString,
+			},
+			"password": {
+				Computed:    true,
+				Description: "Current password of the role in Postgres.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+			"uri": {
+				Computed:    true,
+				Description: "A full URI usable as a Postgres connection string for the role.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// resourceClusterRoleRotationCustomizeDiff schedules a rotation by marking the rotation
+// results as unknown, which Update then relies on to decide whether to rotate
+func resourceClusterRoleRotationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Create always rotates, nothing to schedule
+	if d.Id() == "" {
+		return nil
+	}
+
+	rotate := d.HasChange("rotate_triggers")
+	if days := d.Get("rotation_days").(int); !rotate && days > 0 {
+		lastRotated, err := time.Parse(time.RFC3339, d.Get("last_rotated_at").(string))
+		if err != nil {
+			return fmt.Errorf("error parsing last_rotated_at: %w", err)
+		}
+		rotate = time.Since(lastRotated) >= time.Duration(days)*24*time.Hour
+	}
+
+	if rotate {
+		for _, key := range []string{"last_rotated_at", "password", "uri"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func rotateClusterRole(ctx context.Context, d *schema.ResourceData, client *bridgeapi.Client) diag.Diagnostics {
+	clusterID := d.Get("cluster_id").(string)
+	name := d.Get("role_name").(string)
+
+	tflog.Trace(ctx, "sending cluster role password rotation request to API", map[string]interface{}{
+		"role": name,
+	})
+
+	role, err := client.RotateClusterRolePassword(clusterID, name)
+	if err != nil {
+		return diag.Errorf("failed to rotate password for role %s: %s", name, err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("last_rotated_at", time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("password", role.Password)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("uri", role.URI)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+func resourceClusterRoleRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	diags := rotateClusterRole(ctx, d, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(clusterRoleID(d.Get("cluster_id").(string), d.Get("role_name").(string)))
+
+	return diags
+}
+
+func resourceClusterRoleRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	clusterID, name, err := parseClusterRoleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role, err := client.ClusterRole(clusterID, name)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "rotated cluster role no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	// Password may have been changed outside of terraform, last_rotated_at
+	// only reflects rotations done by this resource though
+	err = d.Set("password", role.Password)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("uri", role.URI)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceClusterRoleRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	// Only rotate when planned by resourceClusterRoleRotationCustomizeDiff, changes to
+	// rotation_days alone just update the schedule
+	if d.HasChange("last_rotated_at") {
+		return rotateClusterRole(ctx, d, client)
+	}
+
+	return nil
+}
+
+func resourceClusterRoleRotationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Nothing to undo, the role keeps its current password
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// fakeClusterAPI serves cluster actions and reports the given states from the
+// status endpoint in order, repeating the last one once they run out
+type fakeClusterAPI struct {
+	sync.Mutex
+	actions []string
+	states  []bridgeapi.ClusterState
+}
+
+func (f *fakeClusterAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	switch {
+	case r.Method == http.MethodPut && r.URL.Path == "/clusters/abc/actions/suspend",
+		r.Method == http.MethodPut && r.URL.Path == "/clusters/abc/actions/resume":
+		f.actions = append(f.actions, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && r.URL.Path == "/clusters/abc/status":
+		state := f.states[0]
+		if len(f.states) > 1 {
+			f.states = f.states[1:]
+		}
+		_ = json.NewEncoder(w).Encode(bridgeapi.ClusterStatus{State: state})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeClusterClient(t *testing.T, api http.Handler) *bridgeapi.Client {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	target, _ := url.Parse(server.URL)
+	client, err := bridgeapi.NewClient(target, bridgeapi.Login{Secret: "cbkey_test"})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	return client
+}
+
+func TestSetClusterSuspended(t *testing.T) {
+	defer func(interval time.Duration) { clusterPollInterval = interval }(clusterPollInterval)
+	clusterPollInterval = time.Millisecond
+
+	cases := []struct {
+		name    string
+		suspend bool
+		states  []bridgeapi.ClusterState
+	}{
+		{
+			name:    "suspend",
+			suspend: true,
+			states: []bridgeapi.ClusterState{
+				bridgeapi.ClusterStateReady,
+				bridgeapi.ClusterStateSuspending,
+				bridgeapi.ClusterStateSuspended,
+			},
+		},
+		{
+			name: "resume",
+			states: []bridgeapi.ClusterState{
+				bridgeapi.ClusterStateSuspended,
+				bridgeapi.ClusterStateSuspended,
+				bridgeapi.ClusterStateResuming,
+				bridgeapi.ClusterStateReady,
+			},
+		},
+		{
+			name:    "suspend skips transitional state",
+			suspend: true,
+			states: []bridgeapi.ClusterState{
+				bridgeapi.ClusterStateReady,
+				bridgeapi.ClusterStateSuspended,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &fakeClusterAPI{states: tc.states}
+			client := newFakeClusterClient(t, api)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := setClusterSuspended(ctx, client, "abc", tc.suspend); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(api.actions) != 1 {
+				t.Errorf("expected one action request, got %v", api.actions)
+			}
+			if len(api.states) != 1 {
+				t.Errorf("returned before reaching the final state, %d states left", len(api.states))
+			}
+		})
+	}
+}
+
+func TestSetClusterSuspendedFailed(t *testing.T) {
+	defer func(interval time.Duration) { clusterPollInterval = interval }(clusterPollInterval)
+	clusterPollInterval = time.Millisecond
+
+	api := &fakeClusterAPI{states: []bridgeapi.ClusterState{
+		bridgeapi.ClusterStateReady,
+		bridgeapi.ClusterStateSuspending,
+		bridgeapi.ClusterStateFailed,
+	}}
+	client := newFakeClusterClient(t, api)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := setClusterSuspended(ctx, client, "abc", true); err == nil {
+		t.Fatal("expected an error for a failed cluster")
+	}
+}
+
+// TestMaintWindowReadDiff reads a cluster from the API and diffs it against the
+// configuration, checking that midnight windows can be told apart from none
+func TestMaintWindowReadDiff(t *testing.T) {
+	midnight := 0
+
+	cases := []struct {
+		name       string
+		apiStart   *int
+		configured string
+		wantState  string
+		wantDiff   bool
+	}{
+		{
+			name: "no window",
+		},
+		{
+			name:       "midnight set",
+			configured: "0",
+			wantDiff:   true,
+		},
+		{
+			name:      "midnight removed",
+			apiStart:  &midnight,
+			wantState: "0",
+			wantDiff:  true,
+		},
+		{
+			name:       "midnight unchanged",
+			apiStart:   &midnight,
+			configured: "0",
+			wantState:  "0",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newFakeClusterClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/clusters/abc" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(bridgeapi.ClusterDetail{ID: "abc", MaintWindowStart: tc.apiStart})
+			}))
+
+			config := map[string]interface{}{
+				"name":    "test-cluster",
+				"team_id": "abcdefghijklmnopqrstuvwxyz",
+			}
+			if tc.configured != "" {
+				config["maintenance_window_start"] = tc.configured
+			}
+
+			r := resourceCluster()
+			d := schema.TestResourceDataRaw(t, r.Schema, config)
+			d.SetId("abc")
+			if diags := resourceClusterRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected read error: %v", diags)
+			}
+
+			state := d.State()
+			if got := state.Attributes["maintenance_window_start"]; got != tc.wantState {
+				t.Errorf("got state %q, want %q", got, tc.wantState)
+			}
+
+			diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+			if err != nil {
+				t.Fatalf("unexpected diff error: %s", err)
+			}
+			var gotDiff bool
+			if diff != nil {
+				_, gotDiff = diff.Attributes["maintenance_window_start"]
+			}
+			if gotDiff != tc.wantDiff {
+				t.Errorf("got diff %t, want %t", gotDiff, tc.wantDiff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Firewall rule resource for the Crunchy Bridge Terraform Provider. Each rule allows " +
+			"connections from a CIDR block to the clusters in a network.",
+
+		CreateContext: resourceFirewallRuleCreate,
+		ReadContext:   resourceFirewallRuleRead,
+		UpdateContext: resourceFirewallRuleUpdate,
+		DeleteContext: resourceFirewallRuleDelete,
+
+		CustomizeDiff: resourceFirewallRuleCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"network_id": {
+				Computed:     true,
+				Description:  "The ID of the network in [EID format](https://docs.crunchybridge.com/api-concepts/eid) the rule applies to. Exactly one of `network_id` or `cluster_id` is required, and it is set from the cluster when `cluster_id` is given.",
+				ExactlyOneOf: []string{"network_id", "cluster_id"},
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"cluster_id": {
+				Description: "The ID of a cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) whose network the rule applies to. " +
+					"Rules apply to every cluster in the network, so the rule is only replaced when the cluster's network differs.",
+				ExactlyOneOf: []string{"network_id", "cluster_id"},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"cidr": {
+				Description:  "The CIDR block allowed to connect, for example `203.0.113.0/24`. Must be the network address of the block.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDRNetwork,
+			},
+			"description": {
+				Description: "A human-readable description of the rule.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the rule in the form `<network_id>/<rule_id>`, also used for import.",
+				Type:        schema.TypeString,
+			},
+			"rule_id": {
+				Computed:    true,
+				Description: "The ID of the rule in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// validateCIDRNetwork accepts IPv4 or IPv6 CIDR blocks, rejecting ones with host
+// bits set since the API stores the network address and would report a difference
+func validateCIDRNetwork(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	ip, network, err := net.ParseCIDR(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid CIDR block, got %q: %w", k, v, err)}
+	}
+	if !ip.Equal(network.IP) {
+		return nil, []error{fmt.Errorf("expected %s to be the network address of the block, got %q, did you mean %q?", k, v, network.String())}
+	}
+
+	return nil, nil
+}
+
+// resourceFirewallRuleCustomizeDiff plans network_id from cluster_id, so that a new
+// cluster_id only replaces the rule when it points at a different network
+func resourceFirewallRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cluster_id") {
+		return d.SetNewComputed("network_id")
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	if clusterID == "" || (d.Id() != "" && !d.HasChange("cluster_id")) {
+		return nil
+	}
+
+	client, ok := meta.(*bridgeapi.Client)
+	if !ok || client == nil {
+		return nil
+	}
+	cd, err := client.ClusterDetail(clusterID)
+	if err != nil {
+		return fmt.Errorf("error looking up cluster network: %w", err)
+	}
+	if cd.NetworkID == "" {
+		return fmt.Errorf("cluster %s did not report a network", cd.ID)
+	}
+
+	if cd.NetworkID != d.Get("network_id").(string) {
+		return d.SetNew("network_id", cd.NetworkID)
+	}
+	return nil
+}
+
+// networkIDFromConfig returns the configured network_id, or the network of the
+// configured cluster_id when network_id isn't set
+func networkIDFromConfig(client *bridgeapi.Client, d *schema.ResourceData) (string, error) {
+	if networkID, ok := d.GetOk("network_id"); ok {
+		return networkID.(string), nil
+	}
+
+	cd, err := client.ClusterDetail(d.Get("cluster_id").(string))
+	if err != nil {
+		return "", fmt.Errorf("error looking up cluster network: %w", err)
+	}
+	if cd.NetworkID == "" {
+		return "", fmt.Errorf("cluster %s did not report a network", cd.ID)
+	}
+
+	return cd.NetworkID, nil
+}
+
+func firewallRuleRequest(d *schema.ResourceData) bridgeapi.FirewallRuleRequest {
+	// Always send description so that removing it from configuration clears it
+	description := d.Get("description").(string)
+	return bridgeapi.FirewallRuleRequest{
+		Description: &description,
+		Rule:        d.Get("cidr").(string),
+	}
+}
+
+func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	networkID, err := networkIDFromConfig(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Trace(ctx, "sending firewall rule create request to API")
+
+	rule, err := client.CreateFirewallRule(networkID, firewallRuleRequest(d))
+	if err != nil {
+		return diag.Errorf("failed to create firewall rule: %s", err)
+	}
+
+	d.SetId(joinID(networkID, rule.ID))
+
+	readDiag := resourceFirewallRuleRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	parts, err := splitID(d.Id(), "network_id", "rule_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	networkID, ruleID := parts[0], parts[1]
+
+	rule, err := client.FirewallRule(networkID, ruleID)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "firewall rule no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("network_id", networkID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("rule_id", rule.ID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("cidr", rule.Rule)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("description", rule.Description)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	if d.HasChanges("cidr", "description") {
+		_, err := client.UpdateFirewallRule(d.Get("network_id").(string), d.Get("rule_id").(string), firewallRuleRequest(d))
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while updating firewall rule: %s", err)...)
+		}
+	}
+
+	readDiag := resourceFirewallRuleRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.DeleteFirewallRule(d.Get("network_id").(string), d.Get("rule_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLogDestination() *schema.Resource {
+	return &schema.Resource{
+		Description: "Log destination resource for the Crunchy Bridge Terraform Provider. Ships the cluster's " +
+			"Postgres logs to a syslog endpoint, such as a central syslog server, Datadog, or Papertrail.",
+
+		CreateContext: resourceLogDestinationCreate,
+		ReadContext:   resourceLogDestinationRead,
+		UpdateContext: resourceLogDestinationUpdate,
+		DeleteContext: resourceLogDestinationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"cluster_id": {
+				Description:  "The ID of the cluster in [EID format](https://docs.crunchybridge.com/api-concepts/eid) whose logs are shipped.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"host": {
+				Description:  "The host name of the syslog endpoint.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"port": {
+				Description:  "The port of the syslog endpoint.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"template": {
+				Description:  "The syslog template used to format each log message, which typically includes any token required by the endpoint.",
+				Required:     true,
+				Sensitive:    true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Description: "A human-readable description of the log destination.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the log destination in the form `<cluster_id>/<destination_id>`, also used for import.",
+				Type:        schema.TypeString,
+			},
+			"destination_id": {
+				Computed:    true,
+				Description: "The ID of the log destination in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func logDestinationRequest(d *schema.ResourceData) bridgeapi.LogDestinationRequest {
+	return bridgeapi.LogDestinationRequest{
+		Description: d.Get("description").(string),
+		Host:        d.Get("host").(string),
+		Port:        d.Get("port").(int),
+		Template:    d.Get("template").(string),
+	}
+}
+
+func resourceLogDestinationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	clusterID := d.Get("cluster_id").(string)
+
+	tflog.Trace(ctx, "sending log destination create request to API")
+
+	destination, err := client.CreateLogDestination(clusterID, logDestinationRequest(d))
+	if err != nil {
+		return diag.Errorf("failed to create log destination: %s", err)
+	}
+
+	d.SetId(joinID(clusterID, destination.ID))
+
+	readDiag := resourceLogDestinationRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceLogDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	parts, err := splitID(d.Id(), "cluster_id", "destination_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, destinationID := parts[0], parts[1]
+
+	destination, err := client.LogDestination(clusterID, destinationID)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "log destination no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("cluster_id", clusterID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("destination_id", destination.ID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("description", destination.Description)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("host", destination.Host)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("port", destination.Port)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("template", destination.Template)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceLogDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	if d.HasChanges("description", "host", "port", "template") {
+		_, err := client.UpdateLogDestination(d.Get("cluster_id").(string), d.Get("destination_id").(string), logDestinationRequest(d))
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while updating log destination: %s", err)...)
+		}
+	}
+
+	readDiag := resourceLogDestinationRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceLogDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.DeleteLogDestination(d.Get("cluster_id").(string), d.Get("destination_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
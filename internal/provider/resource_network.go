@@ -0,0 +1,203 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		Description: "Network resource for the Crunchy Bridge Terraform Provider. Clusters placed in the same network share its firewall rules and peering.",
+
+		CreateContext: resourceNetworkCreate,
+		ReadContext:   resourceNetworkRead,
+		UpdateContext: resourceNetworkUpdate,
+		DeleteContext: resourceNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"name": {
+				Description:  "A human-readable name for the network.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"team_id": {
+				Description:  "The ID of the parent [team](https://docs.crunchybridge.com/concepts/teams/) for the network.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"provider_id": {
+				Description:  "The [cloud provider](https://docs.crunchybridge.com/api/provider) where the network is located. Allows `aws`, `gcp`, or `azure`",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"aws", "gcp", "azure"}, false),
+			},
+			"region_id": {
+				Description: "The [provider region](https://docs.crunchybridge.com/api/provider#region) where the network is located.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"cidr": {
+				Computed:     true,
+				Description:  "The IPv4 CIDR block of the network, for example `10.0.0.0/16`. Defaults to the API's choice when unset.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.All(validation.IsCIDRNetwork(8, 28), validateCIDRNetwork),
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The unique ID of the network in [EID format](https://docs.crunchybridge.com/api-concepts/eid)",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	req := bridgeapi.NetworkCreateRequest{
+		CIDR4:      d.Get("cidr").(string),
+		Name:       d.Get("name").(string),
+		ProviderID: d.Get("provider_id").(string),
+		RegionID:   d.Get("region_id").(string),
+		TeamID:     d.Get("team_id").(string),
+	}
+
+	tflog.Trace(ctx, "sending network create request to API")
+
+	network, err := client.CreateNetwork(req)
+	if err != nil {
+		return diag.Errorf("failed to create network: %s", err)
+	}
+
+	d.SetId(network.ID)
+
+	readDiag := resourceNetworkRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	network, err := client.Network(d.Id())
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "network no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return setNetworkData(d, network)
+}
+
+// setNetworkData maps network fields shared by the resource and data source
+func setNetworkData(d *schema.ResourceData, network bridgeapi.Network) diag.Diagnostics {
+	diags := []diag.Diagnostic{}
+
+	err := d.Set("id", network.ID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("cidr", network.CIDR4)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("name", network.Name)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("provider_id", network.ProviderID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("region_id", network.RegionID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("team_id", network.TeamID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	noUpdSupport := []string{"cidr", "provider_id", "region_id", "team_id"}
+	for _, key := range noUpdSupport {
+		if d.HasChange(key) {
+			diags = append(diags, diag.Errorf("provider does not support in-place update for [%s]", key)...)
+		}
+	}
+	// If unsupported fields have changed, error out so the user can correct them before applying good changes
+	if len(diags) > 0 {
+		return diags
+	}
+
+	if d.HasChange("name") {
+		newName := d.Get("name").(string)
+		err := client.UpdateNetwork(d.Id(), bridgeapi.NetworkUpdateRequest{
+			Name: &newName,
+		})
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while updating network name: %s", err)...)
+		}
+	}
+
+	readDiag := resourceNetworkRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.DeleteNetwork(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
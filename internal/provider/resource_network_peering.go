@@ -0,0 +1,277 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNetworkPeering() *schema.Resource {
+	return &schema.Resource{
+		Description: "Network peering resource for the Crunchy Bridge Terraform Provider. Peers a Bridge network with " +
+			"an AWS VPC or Azure VNet. The peering must be accepted on the cloud side, using `peering_identifier` " +
+			"for AWS or by creating the reverse peering to `network_identifier` for Azure.",
+
+		CreateContext: resourceNetworkPeeringCreate,
+		ReadContext:   resourceNetworkPeeringRead,
+		UpdateContext: resourceNetworkPeeringUpdate,
+		DeleteContext: resourceNetworkPeeringDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"network_id": {
+				Description:  "The ID of the Bridge network in [EID format](https://docs.crunchybridge.com/api-concepts/eid) to peer.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"peer_account_id": {
+				Description: "The cloud account owning the peer network, an AWS account ID or an Azure subscription ID.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"peer_vpc_id": {
+				Description: "The peer network, an AWS VPC ID such as `vpc-0123456789abcdef0` or an Azure VNet resource ID.",
+				ForceNew:    true,
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+			"peer_cidr": {
+				Description:  "The IPv4 CIDR block of the peer network, which must not overlap the Bridge network.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDRNetwork,
+			},
+			"peer_region_id": {
+				Computed:    true,
+				Description: "The region of the peer network. Defaults to the region of the Bridge network.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"wait_until_active": {
+				Description: "Treats the create operation as incomplete until the peering reports an active status. " +
+					"Only enable this when the peering is accepted independently of this resource, since an acceptance " +
+					"referencing its attributes can't start until creation completes. Otherwise creation completes once " +
+					"the peering is ready to be accepted. Defaults to `false`",
+				Optional: true,
+				Type:     schema.TypeBool,
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the peering in the form `<network_id>/<peering_id>`, also used for import.",
+				Type:        schema.TypeString,
+			},
+			"network_identifier": {
+				Computed:    true,
+				Description: "The cloud identifier of the Bridge side of the peering, an AWS VPC ID or an Azure VNet resource ID.",
+				Type:        schema.TypeString,
+			},
+			"peering_id": {
+				Computed:    true,
+				Description: "The ID of the peering in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+			"peering_identifier": {
+				Computed:    true,
+				Description: "The cloud identifier of the peering connection to accept, for example an AWS `pcx-` ID. Null until the peering is provisioned.",
+				Type:        schema.TypeString,
+			},
+			"status": {
+				Computed:    true,
+				Description: "The status of the peering, for example `pending_acceptance` or `active`.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceNetworkPeeringCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	networkID := d.Get("network_id").(string)
+	req := bridgeapi.NetworkPeeringCreateRequest{
+		PeerAccountID: d.Get("peer_account_id").(string),
+		PeerCIDR4:     d.Get("peer_cidr").(string),
+		PeerRegionID:  d.Get("peer_region_id").(string),
+		PeerVPCID:     d.Get("peer_vpc_id").(string),
+	}
+
+	tflog.Trace(ctx, "sending network peering create request to API")
+
+	peering, err := client.CreateNetworkPeering(networkID, req)
+	if err != nil {
+		return diag.Errorf("failed to create network peering: %s", err)
+	}
+
+	d.SetId(joinID(networkID, peering.ID))
+
+	err = waitForNetworkPeering(ctx, client, networkID, peering.ID, d.Get("wait_until_active").(bool))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	readDiag := resourceNetworkPeeringRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+// waitForNetworkPeering polls the peering until it's active or, unless untilActive
+// is set, until it's waiting on acceptance and its identifiers are available
+func waitForNetworkPeering(ctx context.Context, client *bridgeapi.Client, networkID, peeringID string, untilActive bool) error {
+	delay := 10 * time.Second
+	var status bridgeapi.PeeringStatus
+	for elapsed := time.Duration(0); ; elapsed += delay {
+		peering, err := client.NetworkPeering(networkID, peeringID)
+		if err != nil {
+			tflog.Error(ctx, "error obtaining network peering status", map[string]interface{}{
+				"error": err,
+				"time":  elapsed.String(),
+			})
+		} else {
+			status = peering.Status
+			switch {
+			case status == bridgeapi.PeeringStatusActive:
+				tflog.Debug(ctx, "Completed waiting on network peering, "+elapsed.String()+" elapsed.")
+				return nil
+			case status == bridgeapi.PeeringStatusPendingAcceptance && !untilActive:
+				return nil
+			case status.IsFailed():
+				return fmt.Errorf("network peering %s reported status %s", peeringID, status)
+			case status == bridgeapi.PeeringStatusDeleting:
+				return fmt.Errorf("network peering %s is being deleted", peeringID)
+			case !status.Known():
+				tflog.Warn(ctx, "unrecognized network peering status while waiting", map[string]interface{}{
+					"status": string(status),
+				})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for network peering %s, last status: %s", peeringID, status)
+		case <-time.After(delay):
+		}
+	}
+}
+
+func resourceNetworkPeeringRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	parts, err := splitID(d.Id(), "network_id", "peering_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	networkID, peeringID := parts[0], parts[1]
+
+	peering, err := client.NetworkPeering(networkID, peeringID)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "network peering no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("network_id", networkID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("peering_id", peering.ID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("network_identifier", peering.NetworkIdentifier)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("peer_account_id", peering.PeerAccountID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("peer_cidr", peering.PeerCIDR4)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("peer_region_id", peering.PeerRegionID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("peer_vpc_id", peering.PeerVPCID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	// Don't set peering_identifier until the peering is provisioned, leaving it null
+	if peering.PeeringIdentifier != nil {
+		err = d.Set("peering_identifier", *peering.PeeringIdentifier)
+		if err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+	err = d.Set("status", string(peering.Status))
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceNetworkPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Every peering attribute forces replacement, only wait_until_active can change
+	// in place and it only applies during create
+	return resourceNetworkPeeringRead(ctx, d, meta)
+}
+
+func resourceNetworkPeeringDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.DeleteNetworkPeering(d.Get("network_id").(string), d.Get("peering_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/CrunchyData/terraform-provider-crunchybridge/internal/bridgeapi"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// teamRoles are the roles an account can hold within a team
+var teamRoles = []string{"admin", "manager", "member"}
+
+func resourceTeamMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Team member resource for the Crunchy Bridge Terraform Provider. Adds an account to a team " +
+			"by email, inviting it if no account exists yet, and removes it from the team on destroy.",
+
+		CreateContext: resourceTeamMemberCreate,
+		ReadContext:   resourceTeamMemberRead,
+		UpdateContext: resourceTeamMemberUpdate,
+		DeleteContext: resourceTeamMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			// "Request" Fields
+			"team_id": {
+				Description:  "The ID of the [team](https://docs.crunchybridge.com/concepts/teams/) in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringLenBetween(26, 26),
+			},
+			"email": {
+				Description: "The email address of the account to add to the team.",
+				// Email addresses are matched case-insensitively by the API
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+				ForceNew: true,
+				Required: true,
+				Type:     schema.TypeString,
+			},
+			"role": {
+				Description:  "The member's role in the team. Allows `admin`, `manager`, or `member`.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(teamRoles, false),
+			},
+			// "Result / Computed Fields"
+			"id": {
+				Computed:    true,
+				Description: "The ID of the membership in the form `<team_id>/<member_id>`, also used for import.",
+				Type:        schema.TypeString,
+			},
+			"account_id": {
+				Computed:    true,
+				Description: "The ID of the member's account in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+			"member_id": {
+				Computed:    true,
+				Description: "The ID of the membership in [EID format](https://docs.crunchybridge.com/api-concepts/eid).",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceTeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	teamID := d.Get("team_id").(string)
+
+	tflog.Trace(ctx, "sending team member create request to API")
+
+	member, err := client.AddTeamMember(teamID, bridgeapi.TeamMemberCreateRequest{
+		Email: d.Get("email").(string),
+		Role:  d.Get("role").(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to add team member: %s", err)
+	}
+
+	d.SetId(joinID(teamID, member.ID))
+
+	readDiag := resourceTeamMemberRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceTeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+
+	parts, err := splitID(d.Id(), "team_id", "member_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	teamID, memberID := parts[0], parts[1]
+
+	member, err := client.TeamMember(teamID, memberID)
+	if errors.Is(err, bridgeapi.ErrorNotFound) {
+		tflog.Warn(ctx, "team member no longer exists, removing from state", map[string]interface{}{
+			"id": d.Id(),
+		})
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := []diag.Diagnostic{}
+
+	err = d.Set("team_id", teamID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("member_id", member.ID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("account_id", member.AccountID)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("email", member.Email)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	err = d.Set("role", member.Role)
+	if err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diag.Diagnostics(diags)
+}
+
+func resourceTeamMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	if d.HasChange("role") {
+		err := client.UpdateTeamMember(d.Get("team_id").(string), d.Get("member_id").(string), bridgeapi.TeamMemberUpdateRequest{
+			Role: d.Get("role").(string),
+		})
+		if err != nil {
+			diags = append(diags, diag.Errorf("error while updating team member role: %s", err)...)
+		}
+	}
+
+	readDiag := resourceTeamMemberRead(ctx, d, meta)
+	diags = append(diags, readDiag...)
+
+	return diags
+}
+
+func resourceTeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bridgeapi.Client)
+	diags := []diag.Diagnostic{}
+
+	err := client.RemoveTeamMember(d.Get("team_id").(string), d.Get("member_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 Crunchy Data Solutions, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package provider
+
+// tagMap converts a schema map of tags into the form sent to the API
+func tagMap(raw interface{}) map[string]string {
+	tags := map[string]string{}
+	m, _ := raw.(map[string]interface{})
+	for k, v := range m {
+		tags[k] = v.(string)
+	}
+	return tags
+}
+
+// mergeTags overlays the resource's tags on the provider's default tags
+func mergeTags(defaults, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(tags))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// resourceTags recovers a resource's own tags from the full set reported by the
+// API, leaving out default tags unless the resource sets them itself. Tags that
+// are neither configured nor defaults are kept so that they show as drift.
+func resourceTags(all, defaults, configured map[string]string) map[string]string {
+	tags := map[string]string{}
+	for k, v := range all {
+		if _, ok := configured[k]; !ok {
+			if dv, ok := defaults[k]; ok && dv == v {
+				continue
+			}
+		}
+		tags[k] = v
+	}
+	return tags
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceTags(t *testing.T) {
+	defaults := map[string]string{"env": "prod", "owner": "platform"}
+
+	cases := []struct {
+		name       string
+		all        map[string]string
+		configured map[string]string
+		want       map[string]string
+	}{
+		{
+			name: "defaults only",
+			all:  map[string]string{"env": "prod", "owner": "platform"},
+			want: map[string]string{},
+		},
+		{
+			name:       "resource overrides default",
+			all:        map[string]string{"env": "staging", "owner": "platform"},
+			configured: map[string]string{"env": "staging"},
+			want:       map[string]string{"env": "staging"},
+		},
+		{
+			name:       "resource repeats default",
+			all:        map[string]string{"env": "prod", "owner": "platform"},
+			configured: map[string]string{"owner": "platform"},
+			want:       map[string]string{"owner": "platform"},
+		},
+		{
+			name: "default changed outside terraform",
+			all:  map[string]string{"env": "dev", "owner": "platform"},
+			want: map[string]string{"env": "dev"},
+		},
+		{
+			name: "unmanaged tag",
+			all:  map[string]string{"env": "prod", "owner": "platform", "team": "data"},
+			want: map[string]string{"team": "data"},
+		},
+	}
+
+	for _, tc := range cases {
+		got := resourceTags(tc.all, defaults, tc.configured)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: resourceTags() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}